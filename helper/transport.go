@@ -7,20 +7,26 @@ package helper
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"github.com/google/uuid"
+	"io"
 	"log"
+	"math"
 	"net/http"
 	"net/http/httputil"
 	"os"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/vault/sdk/helper/salt"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -31,6 +37,10 @@ const (
 	EnvLogRequestBody = "TERRAFORM_VAULT_LOG_REQUEST_BODY"
 	// EnvLogResponseBody enables logging the response body.
 	EnvLogResponseBody = "TERRAFORM_VAULT_LOG_RESPONSE_BODY"
+
+	// maxRateLimitBackoff caps the backoff between retries of rate limited
+	// requests that do not have a Retry-After header.
+	maxRateLimitBackoff = 30 * time.Second
 )
 
 // TransportOptions for TransportWrapper.
@@ -44,6 +54,62 @@ type TransportOptions struct {
 	// LogResponseBody for all responses, ideally this would only be enabled for debug purposes,
 	// since the response body might contain secrets.
 	LogResponseBody bool
+	// Limiter restricts the rate and the concurrency of all requests, it
+	// can be shared by multiple transports.
+	Limiter *RequestLimiter
+	// MaxRetriesRateLimit is the maximum number of times that a request
+	// is retried after a 429 (Too Many Requests) response.
+	MaxRetriesRateLimit int
+}
+
+// RequestLimiter restricts the number of requests per second and the number
+// of in-flight requests.
+type RequestLimiter struct {
+	limiter *rate.Limiter
+	sem     chan struct{}
+}
+
+// NewRequestLimiter returns a RequestLimiter that allows up to requestsPerSecond
+// requests per second, and up to maxConcurrentRequests in-flight requests.
+// Zero disables the corresponding limit, nil is returned if both are disabled.
+func NewRequestLimiter(requestsPerSecond float64, maxConcurrentRequests int) *RequestLimiter {
+	if requestsPerSecond <= 0 && maxConcurrentRequests <= 0 {
+		return nil
+	}
+
+	l := &RequestLimiter{}
+	if requestsPerSecond > 0 {
+		burst := int(math.Ceil(requestsPerSecond))
+		l.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+
+	if maxConcurrentRequests > 0 {
+		l.sem = make(chan struct{}, maxConcurrentRequests)
+	}
+
+	return l
+}
+
+// acquire blocks until the request is allowed to be sent, the returned
+// function must be called once the request is done.
+func (l *RequestLimiter) acquire(req *http.Request) (func(), error) {
+	ctx := req.Context()
+	if l.limiter != nil {
+		if err := l.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if l.sem == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case l.sem <- struct{}{}:
+		return func() { <-l.sem }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
 }
 
 // DefaultTransportOptions for setting up the HTTP TransportWrapper wrapper.
@@ -121,7 +187,7 @@ func (t *TransportWrapper) RoundTrip(req *http.Request) (*http.Response, error)
 		}
 	}
 
-	resp, err := t.transport.RoundTrip(req)
+	resp, err := t.roundTripWithRetry(req)
 	if err != nil {
 		return resp, err
 	}
@@ -138,6 +204,132 @@ func (t *TransportWrapper) RoundTrip(req *http.Request) (*http.Response, error)
 	return resp, nil
 }
 
+// roundTripWithRetry sends the request with the configured limits, requests
+// that are rate limited by Vault are retried after the delay requested in the
+// Retry-After header, or with an exponential backoff.
+func (t *TransportWrapper) roundTripWithRetry(req *http.Request) (*http.Response, error) {
+	maxRetries := t.options.MaxRetriesRateLimit
+	if maxRetries > 0 && req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// buffer the body so that it can be sent again
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		req = req.Clone(req.Context())
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+		req.Body, _ = req.GetBody()
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.limitedRoundTrip(req)
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests || attempt >= maxRetries {
+			return resp, err
+		}
+
+		wait := RetryAfter(resp, attempt)
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		log.Printf("[WARN] %s API request to %s was rate limited, retrying in %s (%d/%d)",
+			t.name, req.URL.Path, wait, attempt+1, maxRetries)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+func (t *TransportWrapper) limitedRoundTrip(req *http.Request) (*http.Response, error) {
+	if t.options.Limiter == nil {
+		return t.transport.RoundTrip(req)
+	}
+
+	release, err := t.options.Limiter.acquire(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+
+	// the request stays in-flight until its response body is closed, so that
+	// streamed responses are bounded as well.
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+
+	return resp, nil
+}
+
+// releaseOnClose releases the Limiter slot of a request once its response body
+// is closed.
+type releaseOnClose struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releaseOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+// SkipRateLimitRetry wraps the CheckRetry policy of a client so that requests
+// that are rate limited by Vault are not retried. It is meant for clients whose
+// TransportWrapper retries them already, otherwise every retry of the client
+// would be retried again by the transport.
+func SkipRateLimitRetry(checkRetry retryablehttp.CheckRetry) retryablehttp.CheckRetry {
+	return func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		if err == nil && resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+			return false, nil
+		}
+
+		return checkRetry(ctx, resp, err)
+	}
+}
+
+// RetryAfter returns the delay before retrying a rate limited request. The
+// Retry-After header is honored when it is set, either in seconds or as an
+// HTTP date, otherwise the delay doubles with every attempt up to 30s.
+func RetryAfter(resp *http.Response, attempt int) time.Duration {
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			if wait := time.Until(t); wait > 0 {
+				return wait
+			}
+			return 0
+		}
+	}
+
+	if attempt > 5 {
+		return maxRateLimitBackoff
+	}
+
+	return min(time.Second<<attempt, maxRateLimitBackoff)
+}
+
 func NewTransport(name string, t http.RoundTripper, opts *TransportOptions) *TransportWrapper {
 	return &TransportWrapper{
 		name:      name,
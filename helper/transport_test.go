@@ -0,0 +1,259 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package helper
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransportWrapper_RateLimitRetry(t *testing.T) {
+	var requests atomic.Int32
+	var bodies []string
+	var m sync.Mutex
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		m.Lock()
+		bodies = append(bodies, string(b))
+		m.Unlock()
+
+		if requests.Add(1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	tests := []struct {
+		name       string
+		maxRetries int
+		wantStatus int
+		wantCount  int32
+	}{
+		{
+			name:       "disabled",
+			maxRetries: 0,
+			wantStatus: http.StatusTooManyRequests,
+			wantCount:  1,
+		},
+		{
+			name:       "exhausted",
+			maxRetries: 1,
+			wantStatus: http.StatusTooManyRequests,
+			wantCount:  2,
+		},
+		{
+			name:       "success",
+			maxRetries: 5,
+			wantStatus: http.StatusOK,
+			wantCount:  3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests.Store(0)
+			bodies = nil
+
+			transport := NewTransport("test", http.DefaultTransport, &TransportOptions{
+				MaxRetriesRateLimit: tt.maxRetries,
+			})
+
+			req, err := http.NewRequest(http.MethodPut, ts.URL, io.NopCloser(strings.NewReader("data")))
+			require.NoError(t, err)
+
+			resp, err := transport.RoundTrip(req)
+			require.NoError(t, err)
+			resp.Body.Close()
+
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			assert.Equal(t, tt.wantCount, requests.Load())
+			for _, b := range bodies {
+				assert.Equal(t, "data", b)
+			}
+		})
+	}
+}
+
+func TestTransportWrapper_MaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			cur := maxInFlight.Load()
+			if n <= cur || maxInFlight.CompareAndSwap(cur, n) {
+				break
+			}
+		}
+		time.Sleep(50 * time.Millisecond)
+	}))
+	defer ts.Close()
+
+	transport := NewTransport("test", http.DefaultTransport, &TransportOptions{
+		Limiter: NewRequestLimiter(0, 2),
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, err := http.NewRequest(http.MethodGet, ts.URL, nil)
+			if !assert.NoError(t, err) {
+				return
+			}
+			resp, err := transport.RoundTrip(req)
+			if assert.NoError(t, err) {
+				resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+}
+
+func TestTransportWrapper_MaxConcurrentRequestsBody(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("data"))
+	}))
+	defer ts.Close()
+
+	transport := NewTransport("test", http.DefaultTransport, &TransportOptions{
+		Limiter: NewRequestLimiter(0, 1),
+	})
+
+	req, err := http.NewRequest(http.MethodGet, ts.URL, nil)
+	require.NoError(t, err)
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+
+	// the slot is held until the body of the first response is closed.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = transport.RoundTrip(req.Clone(ctx))
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), err)
+
+	b, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(b))
+	require.NoError(t, resp.Body.Close())
+	require.NoError(t, resp.Body.Close())
+
+	resp, err = transport.RoundTrip(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+}
+
+func TestSkipRateLimitRetry(t *testing.T) {
+	checkRetry := SkipRateLimitRetry(retryablehttp.DefaultRetryPolicy)
+
+	tests := []struct {
+		name       string
+		statusCode int
+		want       bool
+	}{
+		{
+			name:       "rate-limited",
+			statusCode: http.StatusTooManyRequests,
+			want:       false,
+		},
+		{
+			name:       "server-error",
+			statusCode: http.StatusInternalServerError,
+			want:       true,
+		},
+		{
+			name:       "ok",
+			statusCode: http.StatusOK,
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retry, err := checkRetry(context.Background(), &http.Response{StatusCode: tt.statusCode}, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, retry)
+		})
+	}
+}
+
+func TestNewRequestLimiter(t *testing.T) {
+	assert.Nil(t, NewRequestLimiter(0, 0))
+
+	l := NewRequestLimiter(0.5, 0)
+	require.NotNil(t, l)
+	assert.Nil(t, l.sem)
+	assert.Equal(t, 1, l.limiter.Burst())
+
+	l = NewRequestLimiter(10, 4)
+	require.NotNil(t, l)
+	assert.Equal(t, 4, cap(l.sem))
+	assert.Equal(t, 10, l.limiter.Burst())
+}
+
+func TestRetryAfter(t *testing.T) {
+	header := func(v string) *http.Response {
+		resp := &http.Response{Header: http.Header{}}
+		if v != "" {
+			resp.Header.Set("Retry-After", v)
+		}
+		return resp
+	}
+
+	tests := []struct {
+		name    string
+		resp    *http.Response
+		attempt int
+		want    time.Duration
+	}{
+		{
+			name: "seconds",
+			resp: header("7"),
+			want: 7 * time.Second,
+		},
+		{
+			name: "past-date",
+			resp: header(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)),
+			want: 0,
+		},
+		{
+			name:    "backoff",
+			resp:    header(""),
+			attempt: 2,
+			want:    4 * time.Second,
+		},
+		{
+			name:    "invalid",
+			resp:    header("soon"),
+			attempt: 0,
+			want:    time.Second,
+		},
+		{
+			name:    "max-backoff",
+			resp:    header(""),
+			attempt: 10,
+			want:    maxRateLimitBackoff,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RetryAfter(tt.resp, tt.attempt))
+		})
+	}
+}
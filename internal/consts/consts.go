@@ -67,6 +67,9 @@ const (
 	FieldNamespacePath                      = "namespace_path"
 	FieldPathFQ                             = "path_fq"
 	FieldPathsFQ                            = "paths_fq"
+	FieldNamespaces                         = "namespaces"
+	FieldUnlockKeyWO                        = "unlock_key_wo"
+	FieldUnlockKeyWOVersion                 = "unlock_key_wo_version"
 	FieldData                               = "data"
 	FieldDisableRead                        = "disable_read"
 	FieldWriteFields                        = "write_fields"
@@ -384,6 +387,11 @@ const (
 	FieldServiceAccountNames                = "service_account_names"
 	FieldDisableCheckInEnforcement          = "disable_check_in_enforcement"
 	FieldSkipChildToken                     = "skip_child_token"
+	FieldTokenAutoRenew                     = "token_auto_renew"
+	FieldRateLimit                          = "rate_limit"
+	FieldMaxConcurrentRequests              = "max_concurrent_requests"
+	FieldMaxRetriesRateLimit                = "max_retries_rate_limit"
+	FieldPlanCapabilityCheck                = "plan_capability_check"
 	FieldTokenPolicies                      = "token_policies"
 	FieldManagedKeyName                     = "managed_key_name"
 	FieldManagedKeyID                       = "managed_key_id"
@@ -469,6 +477,7 @@ const (
 	FieldCertificateBundle                  = "certificate_bundle"
 	FieldRevoke                             = "revoke"
 	FieldRevokeWithKey                      = "revoke_with_key"
+	FieldRevokeOnClose                      = "revoke_on_close"
 	FieldPrivateKeyType                     = "private_key_type"
 	FieldAddBasicConstraints                = "add_basic_constraints"
 	FieldExported                           = "exported"
@@ -652,6 +661,10 @@ const (
 	FieldCriticalOptions                    = "critical_options"
 	FieldExtensions                         = "extensions"
 	FieldSignedKey                          = "signed_key"
+	FieldIP                                 = "ip"
+	FieldOTP                                = "otp"
+	FieldActive                             = "active"
+	FieldError                              = "error"
 	FieldNoStoreMetadata                    = "no_store_metadata"
 	FieldSerialNumberSource                 = "serial_number_source"
 	FieldCertMetadata                       = "cert_metadata"
@@ -706,6 +719,7 @@ const (
 	FieldPlaintext                   = "plaintext"
 	FieldCiphertext                  = "ciphertext"
 	FieldNewCiphertext               = "new_ciphertext"
+	FieldBits                        = "bits"
 	FieldBindSecretID                = "bind_secret_id"
 	FieldSecretIDBoundCIDRs          = "secret_id_bound_cidrs"
 	FieldSecretIDNumUses             = "secret_id_num_uses"
@@ -743,6 +757,20 @@ const (
 	FieldDeletionTime                         = "deletion_time"
 	FieldDestroyed                            = "destroyed"
 	FieldDeleteAllVersions                    = "delete_all_versions"
+	FieldState                                = "state"
+	FieldCurrentVersion                       = "current_version"
+	FieldOldestVersion                        = "oldest_version"
+	FieldCAS                                  = "cas"
+	FieldAutoCAS                              = "auto_cas"
+	FieldLastWrittenVersion                   = "last_written_version"
+	FieldWriteMode                            = "write_mode"
+	FieldSecretsWO                            = "secrets_wo"
+	FieldMaxConcurrency                       = "max_concurrency"
+	FieldMaxDepth                             = "max_depth"
+	FieldInclude                              = "include"
+	FieldExclude                              = "exclude"
+	FieldSecrets                              = "secrets"
+	FieldUpdatedTime                          = "updated_time"
 	FieldForceNoCache                         = "force_no_cache"
 	FieldDereferenceAliases                   = "dereference_aliases"
 	FieldEnableSamaccountnameLogin            = "enable_samaccountname_login"
@@ -840,6 +868,22 @@ const (
 	FieldValidateAssertionSignature = "validate_assertion_signature"
 	FieldValidateResponseSignature  = "validate_response_signature"
 
+	// Action fields
+	FieldWaitForCompletion = "wait_for_completion"
+	FieldPollInterval      = "poll_interval"
+	FieldPrefix            = "prefix"
+	FieldForce             = "force"
+	FieldSync              = "sync"
+	FieldChecksumFile      = "checksum_file"
+	FieldSHA256            = "sha256"
+
+	// Response wrapping fields
+	FieldWrapTTL         = "wrap_ttl"
+	FieldWrapInfo        = "wrap_info"
+	FieldCreationPath    = "creation_path"
+	FieldCreationTTL     = "creation_ttl"
+	FieldWrappedAccessor = "wrapped_accessor"
+
 	/*
 		agent registry fields
 	*/
@@ -889,6 +933,18 @@ const (
 	FieldAWSSecretAccessKeyWO      = "aws_secret_access_key_wo"
 	FieldSecretsWOVersion          = "secrets_wo_version"
 
+	/*
+		policy document fields
+	*/
+	FieldRules               = "rules"
+	FieldCapabilities        = "capabilities"
+	FieldRequiredParameters  = "required_parameters"
+	FieldSubscribeEventTypes = "subscribe_event_types"
+	FieldAllowedParameters   = "allowed_parameters"
+	FieldDeniedParameters    = "denied_parameters"
+	FieldMinWrappingTTL      = "min_wrapping_ttl"
+	FieldMaxWrappingTTL      = "max_wrapping_ttl"
+
 	/*
 		ephemeral resource constants and write-only attributes
 	*/
@@ -932,6 +988,16 @@ const (
 	EnvVarVaultNamespaceImport = "TERRAFORM_VAULT_NAMESPACE_IMPORT"
 	// EnvVarSkipChildToken to allow user from creating child tokens
 	EnvVarSkipChildToken = "TERRAFORM_VAULT_SKIP_CHILD_TOKEN"
+	// EnvVarTokenAutoRenew to keep the provider token renewed during long runs
+	EnvVarTokenAutoRenew = "TERRAFORM_VAULT_TOKEN_AUTO_RENEW"
+	// EnvVarRateLimit to limit the number of requests per second sent to Vault
+	EnvVarRateLimit = "TERRAFORM_VAULT_RATE_LIMIT"
+	// EnvVarMaxConcurrentRequests to limit the number of in-flight requests
+	EnvVarMaxConcurrentRequests = "TERRAFORM_VAULT_MAX_CONCURRENT_REQUESTS"
+	// EnvVarMaxRetriesRateLimit to retry requests that are rate limited by Vault
+	EnvVarMaxRetriesRateLimit = "TERRAFORM_VAULT_MAX_RETRIES_RATE_LIMIT"
+	// EnvVarPlanCapabilityCheck to check the capabilities of the provider token during plan
+	EnvVarPlanCapabilityCheck = "TERRAFORM_VAULT_PLAN_CAPABILITY_CHECK"
 	// EnvVarUsername to get the username for the userpass auth method
 	EnvVarUsername = "TERRAFORM_VAULT_USERNAME"
 	// EnvVarPassword to get the password for the userpass auth method
@@ -1077,6 +1143,7 @@ const (
 	PathDelim        = "/"
 	VaultAPIV1Root   = "/v1"
 	SysNamespaceRoot = "sys/namespaces/"
+	SysNamespaceLock = "sys/namespaces/api-lock/"
 
 	/*
 		GenericNameRegex is a reusable name pattern fragment for Vault names.
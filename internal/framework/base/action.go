@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package base
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+// BaseActionModel describes common fields for all action configurations.
+type BaseActionModel struct {
+	Namespace types.String `tfsdk:"namespace"`
+}
+
+// ActionWithConfigure is a structure to be embedded within an Action that
+// implements the ActionWithConfigure interface.
+type ActionWithConfigure struct {
+	withMeta
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined Action type.
+func (a *ActionWithConfigure) Configure(_ context.Context, request action.ConfigureRequest, _ *action.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*provider.ProviderMeta); ok {
+		a.meta = v
+	}
+}
+
+// SendProgress reports the progress of an action invocation to Terraform.
+func SendProgress(response *action.InvokeResponse, format string, a ...any) {
+	if response.SendProgress == nil {
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf(format, a...),
+	})
+}
+
+// MustAddBaseActionSchema adds the schema fields that are required for all
+// actions.
+//
+// This should be called from an action's Schema() method.
+func MustAddBaseActionSchema(s *actionschema.Schema) {
+	for k, v := range baseActionSchema() {
+		if _, ok := s.Attributes[k]; ok {
+			panic(fmt.Sprintf("cannot add schema field %q, already exists in the Schema map", k))
+		}
+
+		s.Attributes[k] = v
+	}
+}
+
+func baseActionSchema() map[string]actionschema.Attribute {
+	return map[string]actionschema.Attribute{
+		consts.FieldNamespace: actionschema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Target namespace. (requires Enterprise)",
+			Validators: []validator.String{
+				validators.PathValidator(),
+			},
+		},
+	}
+}
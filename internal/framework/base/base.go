@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
@@ -51,6 +52,11 @@ func (r *ResourceWithConfigure) Configure(_ context.Context, request resource.Co
 type WithImportByID struct{}
 
 func (w *WithImportByID) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	if request.ID == "" && request.Identity != nil {
+		importStateFromIdentity(ctx, request, response)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root(consts.FieldID), request, response)
 
 	ns := os.Getenv(consts.EnvVarVaultNamespaceImport)
@@ -66,6 +72,30 @@ func (w *WithImportByID) ImportState(ctx context.Context, request resource.Impor
 	}
 }
 
+// ImportByIDIdentityModel describes the resource identity of resources that
+// are imported by their "id". The identity carries the namespace along with
+// the ID, so that importing by identity does not depend on the
+// TERRAFORM_VAULT_NAMESPACE_IMPORT environment variable.
+type ImportByIDIdentityModel struct {
+	ID        types.String `tfsdk:"id"`
+	Namespace types.String `tfsdk:"namespace"`
+}
+
+// importStateFromIdentity handles imports that use an import block with an
+// "identity" attribute. Terraform 1.12+ is required.
+func importStateFromIdentity(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	var identity ImportByIDIdentityModel
+	response.Diagnostics.Append(request.Identity.Get(ctx, &identity)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(consts.FieldID), identity.ID)...)
+	if !identity.Namespace.IsNull() && identity.Namespace.ValueString() != "" {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), identity.Namespace)...)
+	}
+}
+
 // DataSourceWithConfigure is a structure to be embedded within a DataSource
 // that implements the DataSourceWithConfigure interface.
 type DataSourceWithConfigure struct {
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package base
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+// CheckPlanCapabilities checks that the provider token has the capabilities
+// to write to the paths of the planned resource, when the
+// plan_capability_check provider argument is enabled. It is meant to be
+// called from the ModifyPlan method of a resource, with the paths that the
+// planned change writes to, once the planned values are set. Paths that are
+// not known during plan must be omitted.
+func (r *ResourceWithConfigure) CheckPlanCapabilities(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, paths []string) {
+	if len(paths) == 0 || resp.Plan.Raw.IsNull() || !r.meta.PlanCapabilityCheck() {
+		return
+	}
+
+	create := req.State.Raw.IsNull()
+	if !create && resp.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var ns types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root(consts.FieldNamespace), &ns)...)
+	if resp.Diagnostics.HasError() || ns.IsUnknown() {
+		return
+	}
+
+	missing, err := r.meta.MissingCapabilities(ctx, ns.ValueString(), paths, create)
+	if err != nil {
+		resp.Diagnostics.AddError("Error checking the capabilities of the provider token", err.Error())
+		return
+	}
+
+	if len(missing) > 0 {
+		resp.Diagnostics.AddError("Missing capabilities",
+			"The provider token lacks the capabilities to apply this resource:\n  "+strings.Join(missing, "\n  "))
+	}
+}
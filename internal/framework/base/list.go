@@ -0,0 +1,265 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package base
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+// BaseListModel describes common fields for all list resource configurations.
+type BaseListModel struct {
+	Namespace types.String `tfsdk:"namespace"`
+}
+
+// MountListModel describes the configuration of list resources that list the
+// objects of a single mount.
+type MountListModel struct {
+	BaseListModel
+
+	Mount types.String `tfsdk:"mount"`
+}
+
+// ListObject is an object found by a list resource.
+type ListObject struct {
+	// ID is the import ID of the object.
+	ID string
+	// DisplayName is the human-readable name of the object.
+	DisplayName string
+}
+
+// ListResourceWithConfigure is a structure to be embedded within a
+// ListResource that implements the ListResourceWithConfigure interface.
+type ListResourceWithConfigure struct {
+	withMeta
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined ListResource type.
+func (r *ListResourceWithConfigure) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*provider.ProviderMeta); ok {
+		r.meta = v
+	}
+}
+
+// SDKv2ListResource is a structure to be embedded within a ListResource whose
+// managed resource is implemented with the SDKv2 and has the
+// provider.GetImportByIDIdentitySchema identity.
+//
+// The SDKv2 resource and its raw schemas are not known to the framework
+// provider, they are set by the provider with SetSDKv2Resource when the list
+// resource is instantiated.
+type SDKv2ListResource struct {
+	ListResourceWithConfigure
+
+	resource       *schema.Resource
+	schema         *tfprotov5.Schema
+	identitySchema *tfprotov5.ResourceIdentitySchema
+}
+
+// SetSDKv2Resource sets the SDKv2 managed resource listed by this list
+// resource, along with its protocol schemas.
+func (r *SDKv2ListResource) SetSDKv2Resource(res *schema.Resource, s *tfprotov5.Schema, identity *tfprotov5.ResourceIdentitySchema) {
+	r.resource = res
+	r.schema = s
+	r.identitySchema = identity
+}
+
+// RawV5Schemas provides the schemas of the SDKv2 managed resource.
+func (r *SDKv2ListResource) RawV5Schemas(_ context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	response.ProtoV5Schema = r.schema
+	response.ProtoV5IdentitySchema = r.identitySchema
+}
+
+// NewListResult returns a list.ListResult for the object with the given import
+// ID. The identity of the result can be used to import the object as-is. If
+// the request includes the resource, it is read with the SDKv2 resource's
+// read function, exactly as it would be after an import.
+func (r *SDKv2ListResource) NewListResult(ctx context.Context, request list.ListRequest, id, namespace, displayName string) list.ListResult {
+	result := request.NewListResult(ctx)
+	result.DisplayName = displayName
+
+	if namespace == "" {
+		// the client was created for the namespace from the environment, see
+		// client.GetClient.
+		namespace = os.Getenv(consts.EnvVarVaultNamespaceImport)
+	}
+
+	identity := ImportByIDIdentityModel{
+		ID:        types.StringValue(id),
+		Namespace: types.StringNull(),
+	}
+	if namespace != "" {
+		identity.Namespace = types.StringValue(namespace)
+	}
+	result.Diagnostics.Append(result.Identity.Set(ctx, identity)...)
+
+	if request.IncludeResource && !result.Diagnostics.HasError() {
+		if err := r.readResource(ctx, request, &result, id, namespace); err != nil {
+			result.Diagnostics.AddError(
+				"Error reading listed resource",
+				fmt.Sprintf("Error reading %q: %s", id, err),
+			)
+		}
+	}
+
+	return result
+}
+
+// Results returns the list results for objects, it stops when Terraform has
+// received enough results.
+func (r *SDKv2ListResource) Results(ctx context.Context, request list.ListRequest, namespace string, objects []ListObject) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for _, o := range objects {
+			if !push(r.NewListResult(ctx, request, o.ID, namespace, o.DisplayName)) {
+				return
+			}
+		}
+	}
+}
+
+func (r *SDKv2ListResource) readResource(ctx context.Context, request list.ListRequest, result *list.ListResult, id, namespace string) error {
+	if r.resource == nil {
+		return fmt.Errorf("no SDKv2 resource set on the list resource")
+	}
+
+	state := &terraform.InstanceState{
+		ID: id,
+		Attributes: map[string]string{
+			consts.FieldID: id,
+		},
+	}
+	if namespace != "" {
+		state.Attributes[consts.FieldNamespace] = namespace
+	}
+
+	newState, diags := r.resource.RefreshWithoutUpgrade(ctx, state, r.Meta())
+	if diags.HasError() {
+		for _, d := range diags {
+			if d.Severity == sdkdiag.Error {
+				return fmt.Errorf("%s: %s", d.Summary, d.Detail)
+			}
+		}
+	}
+	if newState == nil {
+		return fmt.Errorf("resource no longer exists")
+	}
+
+	ty := r.resource.CoreConfigSchema().ImpliedType()
+	val, err := newState.AttrsAsObjectValue(ty)
+	if err != nil {
+		return err
+	}
+
+	b, err := msgpack.Marshal(val, ty)
+	if err != nil {
+		return err
+	}
+
+	raw, err := (&tfprotov5.DynamicValue{MsgPack: b}).Unmarshal(request.ResourceSchema.Type().TerraformType(ctx))
+	if err != nil {
+		return err
+	}
+	result.Resource.Raw = raw
+
+	return nil
+}
+
+// ListResultsError returns a list results stream holding only the given error.
+func ListResultsError(summary, detail string) iter.Seq[list.ListResult] {
+	var diags diag.Diagnostics
+	diags.AddError(summary, detail)
+	return list.ListResultsStreamDiagnostics(diags)
+}
+
+// ListKeys returns the keys at path from a LIST request. An empty slice is
+// returned if nothing exists at path.
+func ListKeys(ctx context.Context, c *api.Client, path string) ([]string, error) {
+	resp, err := c.Logical().ListWithContext(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil || resp.Data == nil {
+		return nil, nil
+	}
+
+	v, ok := resp.Data["keys"].([]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(v))
+	for _, k := range v {
+		keys = append(keys, k.(string))
+	}
+
+	return keys, nil
+}
+
+// ListMountRoles returns the roles of the secrets engine mounted at mount. The
+// ID of each role is "<mount>/roles/<name>".
+func ListMountRoles(ctx context.Context, c *api.Client, mount string) ([]ListObject, error) {
+	mount = strings.Trim(mount, "/")
+	keys, err := ListKeys(ctx, c, mount+"/roles")
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]ListObject, 0, len(keys))
+	for _, k := range keys {
+		objects = append(objects, ListObject{
+			ID:          mount + "/roles/" + k,
+			DisplayName: k,
+		})
+	}
+
+	return objects, nil
+}
+
+// MustAddBaseListSchema adds the schema fields that are required for all list
+// resources.
+//
+// This should be called from a list resource's ListResourceConfigSchema()
+// method.
+func MustAddBaseListSchema(s *listschema.Schema) {
+	for k, v := range baseListSchema() {
+		if _, ok := s.Attributes[k]; ok {
+			panic(fmt.Sprintf("cannot add schema field %q, already exists in the Schema map", k))
+		}
+
+		s.Attributes[k] = v
+	}
+}
+
+func baseListSchema() map[string]listschema.Attribute {
+	return map[string]listschema.Attribute{
+		consts.FieldNamespace: listschema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Target namespace. (requires Enterprise)",
+			Validators: []validator.String{
+				validators.PathValidator(),
+			},
+		},
+	}
+}
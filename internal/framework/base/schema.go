@@ -53,6 +53,11 @@ type BaseModelEphemeral struct {
 	// Adding in MountID enables Ephemeral resource to defer their provisioning if this attribute is set.
 	// This attribute does not do anything, and only exists to maintain a proper dependency graph.
 	MountID types.String `tfsdk:"mount_id"`
+
+	// WrapTTL requests response wrapping for the secret, WrapInfo is set
+	// instead of the resource's computed attributes when it is wrapped.
+	WrapTTL  types.String   `tfsdk:"wrap_ttl"`
+	WrapInfo *WrapInfoModel `tfsdk:"wrap_info"`
 }
 
 // MustAddBaseSchema adds the schema fields that are required for all net new
@@ -134,6 +139,45 @@ func baseEphemeralSchema() map[string]ephemeralschema.Attribute {
 			MarkdownDescription: "Terraform ID of the mount resource. Used to defer the provisioning " +
 				"of the ephemeral resource till the apply stage.",
 		},
+		consts.FieldWrapTTL: ephemeralschema.StringAttribute{
+			Optional: true,
+			MarkdownDescription: "Wrap the response in a single-use token with this TTL, e.g. `5m`. When set, " +
+				"`wrap_info` is returned instead of the secret data.",
+			Validators: []validator.String{
+				validators.DurationValidator(),
+			},
+		},
+		consts.FieldWrapInfo: ephemeralschema.SingleNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "Response wrapping information, only set when `wrap_ttl` is set.",
+			Attributes: map[string]ephemeralschema.Attribute{
+				consts.FieldToken: ephemeralschema.StringAttribute{
+					Computed:            true,
+					Sensitive:           true,
+					MarkdownDescription: "The wrapping token.",
+				},
+				consts.FieldAccessor: ephemeralschema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The accessor of the wrapping token.",
+				},
+				consts.FieldTTL: ephemeralschema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The TTL of the wrapping token in seconds.",
+				},
+				consts.FieldCreationTime: ephemeralschema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The creation time of the wrapping token in RFC3339 format.",
+				},
+				consts.FieldCreationPath: ephemeralschema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The API path of the wrapped response.",
+				},
+				consts.FieldWrappedAccessor: ephemeralschema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The accessor of the wrapped token, only set when the wrapped response is a token.",
+				},
+			},
+		},
 	}
 }
 
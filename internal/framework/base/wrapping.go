@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package base
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/vault/api"
+)
+
+// WrapInfoModel describes the wrap_info attribute of ephemeral resources.
+type WrapInfoModel struct {
+	Token           types.String `tfsdk:"token"`
+	Accessor        types.String `tfsdk:"accessor"`
+	TTL             types.Int64  `tfsdk:"ttl"`
+	CreationTime    types.String `tfsdk:"creation_time"`
+	CreationPath    types.String `tfsdk:"creation_path"`
+	WrappedAccessor types.String `tfsdk:"wrapped_accessor"`
+}
+
+// NewWrapInfoModel returns the WrapInfoModel for the wrap info of a response.
+func NewWrapInfoModel(info *api.SecretWrapInfo) *WrapInfoModel {
+	m := &WrapInfoModel{
+		Token:           types.StringValue(info.Token),
+		Accessor:        types.StringValue(info.Accessor),
+		TTL:             types.Int64Value(int64(info.TTL)),
+		CreationTime:    types.StringValue(info.CreationTime.Format(time.RFC3339)),
+		CreationPath:    types.StringValue(info.CreationPath),
+		WrappedAccessor: types.StringNull(),
+	}
+	if info.WrappedAccessor != "" {
+		m.WrappedAccessor = types.StringValue(info.WrappedAccessor)
+	}
+
+	return m
+}
+
+// IsWrapped returns true if response wrapping was requested with wrap_ttl.
+func (m *BaseModelEphemeral) IsWrapped() bool {
+	return !m.WrapTTL.IsNull() && m.WrapTTL.ValueString() != ""
+}
+
+// WrappingClient returns a copy of the client that wraps all responses with
+// wrap_ttl. The client is returned unchanged if wrap_ttl is not set, so it
+// should only be used for the request whose response is wrapped.
+func (m *BaseModelEphemeral) WrappingClient(c *api.Client) (*api.Client, error) {
+	if !m.IsWrapped() {
+		return c, nil
+	}
+
+	c, err := c.Clone()
+	if err != nil {
+		return nil, fmt.Errorf("error cloning client: %w", err)
+	}
+
+	ttl := m.WrapTTL.ValueString()
+	c.SetWrappingLookupFunc(func(_, _ string) string {
+		return ttl
+	})
+
+	return c, nil
+}
+
+// SetWrapInfo sets wrap_info from a wrapped response. It returns true if the
+// response was wrapped, in which case the rest of the response must be
+// ignored.
+func (m *BaseModelEphemeral) SetWrapInfo(secret *api.Secret) bool {
+	if secret == nil || secret.WrapInfo == nil {
+		return false
+	}
+
+	m.WrapInfo = NewWrapInfoModel(secret.WrapInfo)
+	return true
+}
@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lease
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+)
+
+// privateDataKey is the private data key that stores the lease of an
+// ephemeral resource between Open, Renew and Close.
+const privateDataKey = "lease_data"
+
+// PrivateData describes the lease of an ephemeral resource.
+type PrivateData struct {
+	LeaseID   string `json:"lease_id"`
+	Namespace string `json:"namespace"`
+	Renewable bool   `json:"renewable"`
+	// Increment is the lease duration requested on each renewal, it is set
+	// to the lease duration of the secret when it is read.
+	Increment int `json:"increment"`
+}
+
+// privateGetter is implemented by the private data of the Renew and Close
+// requests.
+type privateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// privateSetter is implemented by the private data of the Open and Renew
+// responses.
+type privateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// EphemeralResourceWithLease should be embedded in ephemeral resources that
+// return a lease instead of base.EphemeralResourceWithConfigure. Leases that
+// are stored with SetLease are renewed with sys/leases/renew before they
+// expire, and revoked when Terraform closes the ephemeral resource.
+type EphemeralResourceWithLease struct {
+	base.EphemeralResourceWithConfigure
+}
+
+// SetLease stores the lease of the secret in the private data of the response
+// and schedules its renewal. Secrets without a lease are ignored.
+func (r *EphemeralResourceWithLease) SetLease(ctx context.Context, resp *ephemeral.OpenResponse, namespace string, secret *api.Secret) {
+	if secret == nil || secret.LeaseID == "" {
+		return
+	}
+
+	privateData := &PrivateData{
+		LeaseID:   secret.LeaseID,
+		Namespace: namespace,
+		Renewable: secret.Renewable,
+		Increment: secret.LeaseDuration,
+	}
+	resp.Diagnostics.Append(setPrivateData(ctx, resp.Private, privateData)...)
+	if privateData.Renewable {
+		resp.RenewAt = RenewAt(time.Now(), secret.LeaseDuration)
+	}
+}
+
+// Renew renews the lease with sys/leases/renew and schedules the next renewal.
+func (r *EphemeralResourceWithLease) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	privateData, diags := getPrivateData(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateData == nil || !privateData.Renewable {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), privateData.Namespace)
+	if err != nil {
+		resp.Diagnostics.AddError("Error configuring Vault client for renew", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Renewing lease", map[string]any{
+		"lease_id": privateData.LeaseID,
+	})
+
+	secret, err := c.Sys().RenewWithContext(ctx, privateData.LeaseID, privateData.Increment)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error renewing lease",
+			fmt.Sprintf("error renewing lease %q: %s", privateData.LeaseID, err),
+		)
+		return
+	}
+
+	if secret == nil {
+		return
+	}
+
+	// a shorter lease means that it was capped by its max TTL, renewing it
+	// again would not extend it any further.
+	if secret.LeaseDuration < privateData.Increment {
+		privateData.Renewable = false
+	} else {
+		resp.RenewAt = RenewAt(time.Now(), secret.LeaseDuration)
+	}
+	resp.Diagnostics.Append(setPrivateData(ctx, resp.Private, privateData)...)
+}
+
+// Close revokes the lease, failures are logged and do not fail the run since
+// the lease expires on its own.
+func (r *EphemeralResourceWithLease) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateData, diags := getPrivateData(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateData == nil {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), privateData.Namespace)
+	if err != nil {
+		resp.Diagnostics.AddError("Error configuring Vault client for revoke", err.Error())
+		return
+	}
+
+	if err := c.Sys().RevokeWithContext(ctx, privateData.LeaseID); err != nil {
+		tflog.Warn(ctx, "Failed to revoke lease", map[string]any{
+			"lease_id": privateData.LeaseID,
+			"error":    err.Error(),
+		})
+		return
+	}
+
+	tflog.Debug(ctx, "Successfully revoked lease", map[string]any{
+		"lease_id": privateData.LeaseID,
+	})
+}
+
+// RenewAt returns the time at which a lease with the given duration in
+// seconds should be renewed, after two thirds of its duration like the Vault
+// Agent. The zero time is returned for leases without a duration.
+func RenewAt(now time.Time, leaseDuration int) time.Time {
+	if leaseDuration <= 0 {
+		return time.Time{}
+	}
+
+	return now.Add(time.Duration(leaseDuration) * time.Second * 2 / 3)
+}
+
+func getPrivateData(ctx context.Context, private privateGetter) (*PrivateData, diag.Diagnostics) {
+	privateBytes, diags := private.GetKey(ctx, privateDataKey)
+	if diags.HasError() || len(privateBytes) == 0 {
+		return nil, diags
+	}
+
+	var privateData PrivateData
+	if err := json.Unmarshal(privateBytes, &privateData); err != nil {
+		diags.AddError("Error unmarshalling lease private data", err.Error())
+		return nil, diags
+	}
+
+	if privateData.LeaseID == "" {
+		return nil, diags
+	}
+
+	return &privateData, diags
+}
+
+func setPrivateData(ctx context.Context, private privateSetter, privateData *PrivateData) diag.Diagnostics {
+	var diags diag.Diagnostics
+	privateBytes, err := json.Marshal(privateData)
+	if err != nil {
+		diags.AddError("Error marshalling lease private data", err.Error())
+		return diags
+	}
+
+	return private.SetKey(ctx, privateDataKey, privateBytes)
+}
@@ -0,0 +1,277 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lease
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+type testPrivateData map[string][]byte
+
+func (d testPrivateData) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return d[key], nil
+}
+
+func (d testPrivateData) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	d[key] = value
+	return nil
+}
+
+func TestRenewAt(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		leaseDuration int
+		want          time.Time
+	}{
+		{
+			name:          "no-duration",
+			leaseDuration: 0,
+			want:          time.Time{},
+		},
+		{
+			name:          "negative-duration",
+			leaseDuration: -1,
+			want:          time.Time{},
+		},
+		{
+			name:          "one-hour",
+			leaseDuration: 3600,
+			want:          now.Add(40 * time.Minute),
+		},
+		{
+			name:          "short",
+			leaseDuration: 3,
+			want:          now.Add(2 * time.Second),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RenewAt(now, tt.leaseDuration))
+		})
+	}
+}
+
+func TestPrivateData(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("round-trip", func(t *testing.T) {
+		private := testPrivateData{}
+		want := &PrivateData{
+			LeaseID:   "database/creds/role/abcd",
+			Namespace: "ns1",
+			Renewable: true,
+			Increment: 3600,
+		}
+
+		diags := setPrivateData(ctx, private, want)
+		require.False(t, diags.HasError(), diags)
+
+		got, diags := getPrivateData(ctx, private)
+		require.False(t, diags.HasError(), diags)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		got, diags := getPrivateData(ctx, testPrivateData{})
+		require.False(t, diags.HasError(), diags)
+		assert.Nil(t, got)
+	})
+
+	t.Run("no-lease-id", func(t *testing.T) {
+		private := testPrivateData{
+			privateDataKey: []byte(`{"namespace":"ns1"}`),
+		}
+		got, diags := getPrivateData(ctx, private)
+		require.False(t, diags.HasError(), diags)
+		assert.Nil(t, got)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		private := testPrivateData{
+			privateDataKey: []byte(`{`),
+		}
+		got, diags := getPrivateData(ctx, private)
+		assert.True(t, diags.HasError())
+		assert.Nil(t, got)
+	})
+}
+
+func TestEphemeralResourceWithLease_Renew(t *testing.T) {
+	tests := []struct {
+		name          string
+		leaseDuration int
+		wantRenewable bool
+	}{
+		{
+			name:          "renewed",
+			leaseDuration: 3600,
+			wantRenewable: true,
+		},
+		{
+			name:          "capped",
+			leaseDuration: 60,
+			wantRenewable: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var reqs []map[string]any
+			r := newTestEphemeralResource(t, func(w http.ResponseWriter, req *http.Request) {
+				require.Equal(t, http.MethodPut, req.Method)
+				require.Equal(t, "/v1/sys/leases/renew", req.URL.Path)
+
+				var body map[string]any
+				require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+				reqs = append(reqs, body)
+
+				writeJSON(t, w, map[string]any{
+					"lease_id":       body["lease_id"],
+					"lease_duration": tt.leaseDuration,
+					"renewable":      true,
+				})
+			})
+
+			req := ephemeral.RenewRequest{}
+			req.Private = newPrivateData(req.Private)
+			diags := setPrivateData(ctx, req.Private, &PrivateData{
+				LeaseID:   "database/creds/role/abcd",
+				Renewable: true,
+				Increment: 3600,
+			})
+			require.False(t, diags.HasError(), diags)
+
+			resp := &ephemeral.RenewResponse{}
+			resp.Private = newPrivateData(resp.Private)
+			r.Renew(ctx, req, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			assert.Equal(t, []map[string]any{
+				{
+					"lease_id":  "database/creds/role/abcd",
+					"increment": float64(3600),
+				},
+			}, reqs)
+			assert.Equal(t, tt.wantRenewable, !resp.RenewAt.IsZero())
+
+			got, diags := getPrivateData(ctx, resp.Private)
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, tt.wantRenewable, got.Renewable)
+		})
+	}
+}
+
+func TestEphemeralResourceWithLease_Close(t *testing.T) {
+	ctx := context.Background()
+
+	var revoked []string
+	r := newTestEphemeralResource(t, func(w http.ResponseWriter, req *http.Request) {
+		require.Equal(t, http.MethodPut, req.Method)
+		require.Equal(t, "/v1/sys/leases/revoke", req.URL.Path)
+
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		revoked = append(revoked, body["lease_id"])
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	req := ephemeral.CloseRequest{}
+	req.Private = newPrivateData(req.Private)
+	diags := setPrivateData(ctx, req.Private, &PrivateData{
+		LeaseID: "database/creds/role/abcd",
+	})
+	require.False(t, diags.HasError(), diags)
+
+	resp := &ephemeral.CloseResponse{}
+	r.Close(ctx, req, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, []string{"database/creds/role/abcd"}, revoked)
+}
+
+// newPrivateData returns empty private data for a request or response, the
+// type of the private data is internal to the framework.
+func newPrivateData[T any](*T) *T {
+	return new(T)
+}
+
+// newTestEphemeralResource returns an EphemeralResourceWithLease that is
+// configured with a Vault client for a test server, requests other than the
+// token lookup and child token creation of the client are passed to the
+// handler.
+func newTestEphemeralResource(t *testing.T, handler http.HandlerFunc) *EphemeralResourceWithLease {
+	t.Helper()
+
+	config, ln := testutil.TestHTTPServer(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/v1/auth/token/lookup-self":
+			writeJSON(t, w, map[string]any{
+				"data": map[string]any{
+					"policies": []string{"root"},
+				},
+			})
+		case "/v1/auth/token/create":
+			writeJSON(t, w, map[string]any{
+				"auth": map[string]any{
+					"client_token": "child-token",
+					"policies":     []string{"root"},
+				},
+			})
+		default:
+			handler(w, req)
+		}
+	}))
+	t.Cleanup(func() {
+		ln.Close()
+	})
+
+	d := schema.TestResourceDataRaw(t,
+		map[string]*schema.Schema{
+			consts.FieldAddress: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			consts.FieldToken: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+		map[string]interface{}{
+			consts.FieldAddress: config.Address,
+			consts.FieldToken:   "test-token",
+		},
+	)
+	meta, err := provider.NewProviderMeta(d)
+	require.NoError(t, err)
+
+	r := &EphemeralResourceWithLease{}
+	r.Configure(context.Background(), ephemeral.ConfigureRequest{ProviderData: meta}, &ephemeral.ConfigureResponse{})
+	require.NotNil(t, r.Meta())
+
+	return r
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, v any) {
+	t.Helper()
+
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(t, json.NewEncoder(w).Encode(v))
+}
@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pki
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+// Certificate is a certificate stored by a PKI secrets engine.
+type Certificate struct {
+	SerialNumber   string
+	IssuerID       string
+	CommonName     string
+	NotAfter       time.Time
+	RevocationTime int64
+}
+
+// ListCertificates returns the serial numbers of the certificates stored by
+// the PKI secrets engine mounted at backend, sorted and normalized with
+// NormalizeSerialNumber. Only the revoked certificates are listed if revoked
+// is true.
+func ListCertificates(ctx context.Context, client *api.Client, backend string, revoked bool) ([]string, error) {
+	path := strings.Trim(backend, "/") + "/certs"
+	if revoked {
+		path += "/revoked"
+	}
+
+	resp, err := client.Logical().ListWithContext(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("error listing certificates at %q: %w", path, err)
+	}
+	if resp == nil || resp.Data == nil {
+		return nil, nil
+	}
+
+	keys, _ := resp.Data[consts.FieldKeys].([]interface{})
+	serials := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if s, ok := k.(string); ok {
+			serials = append(serials, NormalizeSerialNumber(s))
+		}
+	}
+	sort.Strings(serials)
+
+	return serials, nil
+}
+
+// ReadCertificate reads the certificate with the given serial number from
+// the PKI secrets engine mounted at backend. It returns nil if the
+// certificate does not exist, e.g. because it was removed by a tidy
+// operation.
+func ReadCertificate(ctx context.Context, client *api.Client, backend, serialNumber string) (*Certificate, error) {
+	path := fmt.Sprintf("%s/cert/%s", strings.Trim(backend, "/"), serialNumber)
+	resp, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading certificate %q: %w", serialNumber, err)
+	}
+	if resp == nil || resp.Data == nil {
+		return nil, nil
+	}
+
+	pemData, _ := resp.Data[consts.FieldCertificate].(string)
+	if pemData == "" {
+		return nil, nil
+	}
+	block, _ := pem.Decode([]byte(pemData))
+	if block == nil {
+		return nil, fmt.Errorf("error decoding certificate %q: no PEM data found", serialNumber)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing certificate %q: %w", serialNumber, err)
+	}
+
+	result := &Certificate{
+		SerialNumber: FormatSerialNumber(cert.SerialNumber),
+		CommonName:   cert.Subject.CommonName,
+		NotAfter:     cert.NotAfter.UTC(),
+	}
+	if v, ok := resp.Data[consts.FieldIssuerID].(string); ok {
+		result.IssuerID = v
+	}
+	if v, ok := resp.Data["revocation_time"].(json.Number); ok {
+		if result.RevocationTime, err = v.Int64(); err != nil {
+			return nil, fmt.Errorf("error parsing revocation time of certificate %q: %w", serialNumber, err)
+		}
+	}
+
+	return result, nil
+}
+
+// ReadRevokedSerialNumbers returns the serial numbers in the CRL of the
+// issuer, or of the default issuer if issuerID is empty, normalized with
+// NormalizeSerialNumber.
+func ReadRevokedSerialNumbers(ctx context.Context, client *api.Client, backend, issuerID string) (map[string]bool, error) {
+	path := strings.Trim(backend, "/") + "/cert/crl"
+	field := consts.FieldCertificate
+	if issuerID != "" {
+		path = fmt.Sprintf("%s/issuer/%s/crl", strings.Trim(backend, "/"), issuerID)
+		field = "crl"
+	}
+
+	resp, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading CRL at %q: %w", path, err)
+	}
+	if resp == nil || resp.Data == nil {
+		return nil, fmt.Errorf("no CRL found at %q", path)
+	}
+
+	pemData, _ := resp.Data[field].(string)
+	block, _ := pem.Decode([]byte(pemData))
+	if block == nil {
+		return nil, fmt.Errorf("error decoding CRL at %q: no PEM data found", path)
+	}
+	crl, err := x509.ParseRevocationList(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing CRL at %q: %w", path, err)
+	}
+
+	result := make(map[string]bool, len(crl.RevokedCertificateEntries))
+	for _, e := range crl.RevokedCertificateEntries {
+		result[FormatSerialNumber(e.SerialNumber)] = true
+	}
+
+	return result, nil
+}
+
+// FormatSerialNumber formats a serial number the way Vault does, as
+// colon-separated lowercase hex bytes.
+func FormatSerialNumber(n *big.Int) string {
+	b := n.Bytes()
+	if len(b) == 0 {
+		b = []byte{0}
+	}
+
+	parts := make([]string, len(b))
+	for i, v := range b {
+		parts[i] = fmt.Sprintf("%02x", v)
+	}
+	return strings.Join(parts, ":")
+}
+
+// NormalizeSerialNumber converts a serial number in the hyphen-separated
+// format of the certs list, or in uppercase, to the format of
+// FormatSerialNumber.
+func NormalizeSerialNumber(s string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(s), "-", ":"))
+}
@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pki
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+// RevokeCertificate revokes the certificate with the given serial number on
+// the PKI secrets engine mounted at backend. If privateKey is set, the
+// revoke-with-key endpoint is used, which proves possession of the private key
+// instead of requiring access to the revoke endpoint.
+func RevokeCertificate(ctx context.Context, client *api.Client, backend, serialNumber, privateKey string) error {
+	data := map[string]interface{}{
+		consts.FieldSerialNumber: serialNumber,
+	}
+
+	path := strings.Trim(backend, "/") + "/revoke"
+	if privateKey != "" {
+		data[consts.FieldPrivateKey] = privateKey
+		path = strings.Trim(backend, "/") + "/revoke-with-key"
+	}
+
+	_, err := client.Logical().WriteWithContext(ctx, path, data)
+	return err
+}
@@ -0,0 +1,298 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl"
+	"github.com/hashicorp/hcl/hcl/ast"
+)
+
+// AllowedCapabilities is the list of capabilities that can be granted on a
+// path in a Vault ACL policy.
+var AllowedCapabilities = []string{
+	"create",
+	"read",
+	"update",
+	"delete",
+	"list",
+	"sudo",
+	"deny",
+	"patch",
+	"subscribe",
+}
+
+type Policy struct {
+	Rules []*PolicyRule
+}
+
+type PolicyRule struct {
+	// Path in Vault that the rule applies to.
+	Path string
+
+	// Description is an optional annotation for the rule.
+	Description string
+
+	// MinWrappingTTL is the minimum allowed TTL for the wrapped response.
+	MinWrappingTTL string
+
+	// MaxWrappingTTL is the maximum allowed TTL for the wrapped response.
+	MaxWrappingTTL string
+
+	// Capabilities is the list of allowed operations on the specified path.
+	Capabilities []string
+
+	// RequiredParameters is a list of parameters that must be specified.
+	RequiredParameters []string
+
+	// SubscribeEventTypes is a list of event types to subscribe to when using `subscribe` capability.
+	SubscribeEventTypes []string
+
+	// AllowedParameters defines a whitelist of keys and values that are permitted on the given path.
+	AllowedParameters map[string][]string
+
+	// DeniedParameters defines a blacklist of keys and values that are denied on the given path.
+	DeniedParameters map[string][]string
+}
+
+// pathRulesHCL mirrors the subset of Vault's own path rules that can be
+// expressed by a PolicyRule.
+type pathRulesHCL struct {
+	Capabilities        []string                 `hcl:"capabilities"`
+	RequiredParameters  []string                 `hcl:"required_parameters"`
+	SubscribeEventTypes []string                 `hcl:"subscribe_event_types"`
+	AllowedParameters   map[string][]interface{} `hcl:"allowed_parameters"`
+	DeniedParameters    map[string][]interface{} `hcl:"denied_parameters"`
+	MinWrappingTTL      interface{}              `hcl:"min_wrapping_ttl"`
+	MaxWrappingTTL      interface{}              `hcl:"max_wrapping_ttl"`
+}
+
+// pathRuleKeys are the keys of a path stanza that are decoded into a
+// PolicyRule, taken from the hcl tags of pathRulesHCL. Any other key, e.g.
+// control_group, is rejected since it would be lost when the policy is
+// rendered again.
+var pathRuleKeys = hclTagNames(reflect.TypeOf(pathRulesHCL{}))
+
+// IsValidCapability reports whether c is one of the AllowedCapabilities.
+func IsValidCapability(c string) bool {
+	for _, capability := range AllowedCapabilities {
+		if c == capability {
+			return true
+		}
+	}
+	return false
+}
+
+// Parse decodes a Vault ACL policy document into a Policy. The lead comment
+// of each path stanza is used as the rule's description, so that the output
+// of Render can be parsed back into an equivalent Policy.
+func Parse(rules string) (*Policy, error) {
+	root, err := hcl.Parse(rules)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse policy: %w", err)
+	}
+
+	list, ok := root.Node.(*ast.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("failed to parse policy: does not contain a root object")
+	}
+
+	policy := &Policy{}
+	for _, item := range list.Filter("path").Items {
+		if len(item.Keys) == 0 {
+			return nil, fmt.Errorf("failed to parse policy: path stanza is missing a key")
+		}
+
+		key, ok := item.Keys[0].Token.Value().(string)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("failed to parse policy: invalid path key at %s", item.Pos())
+		}
+
+		if err := checkPathRuleKeys(key, item.Val); err != nil {
+			return nil, err
+		}
+
+		var raw pathRulesHCL
+		if err := hcl.DecodeObject(&raw, item.Val); err != nil {
+			return nil, fmt.Errorf("failed to parse policy path %q: %w", key, err)
+		}
+
+		for _, c := range raw.Capabilities {
+			if !IsValidCapability(c) {
+				return nil, fmt.Errorf("invalid capability %q in path %q", c, key)
+			}
+		}
+
+		policy.Rules = append(policy.Rules, &PolicyRule{
+			Path:                key,
+			Description:         commentText(item.LeadComment),
+			MinWrappingTTL:      ttlString(raw.MinWrappingTTL),
+			MaxWrappingTTL:      ttlString(raw.MaxWrappingTTL),
+			Capabilities:        raw.Capabilities,
+			RequiredParameters:  raw.RequiredParameters,
+			SubscribeEventTypes: raw.SubscribeEventTypes,
+			AllowedParameters:   parametersToStrings(raw.AllowedParameters),
+			DeniedParameters:    parametersToStrings(raw.DeniedParameters),
+		})
+	}
+
+	return policy, nil
+}
+
+// Render serializes the policy as a standard Vault HCL policy document.
+func Render(policy *Policy) string {
+	var output string
+
+	for i, rule := range policy.Rules {
+		if i == 0 {
+			output = fmt.Sprintf("%s", renderPolicyRule(rule))
+		} else {
+			output = fmt.Sprintf("%s\n%s", output, renderPolicyRule(rule))
+		}
+	}
+
+	return output
+}
+
+func hclTagNames(t reflect.Type) map[string]bool {
+	names := make(map[string]bool, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("hcl"), ",")
+		if name != "" {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+func checkPathRuleKeys(path string, val ast.Node) error {
+	obj, ok := val.(*ast.ObjectType)
+	if !ok {
+		return fmt.Errorf("failed to parse policy path %q: expected an object", path)
+	}
+
+	for _, item := range obj.List.Items {
+		if len(item.Keys) == 0 {
+			continue
+		}
+		k, _ := item.Keys[0].Token.Value().(string)
+		if !pathRuleKeys[k] {
+			return fmt.Errorf("unsupported key %q in policy path %q", k, path)
+		}
+	}
+
+	return nil
+}
+
+func commentText(group *ast.CommentGroup) string {
+	if group == nil {
+		return ""
+	}
+
+	lines := make([]string, 0, len(group.List))
+	for _, c := range group.List {
+		text := strings.TrimPrefix(c.Text, "#")
+		text = strings.TrimPrefix(text, "//")
+		lines = append(lines, strings.TrimSpace(text))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func ttlString(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+func parametersToStrings(input map[string][]interface{}) map[string][]string {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := make(map[string][]string, len(input))
+	for k, values := range input {
+		output[k] = make([]string, len(values))
+		for i, v := range values {
+			output[k][i] = fmt.Sprintf("%v", v)
+		}
+	}
+	return output
+}
+
+// RenderListOfStrings renders items as an HCL list of strings.
+func RenderListOfStrings(items []string) string {
+	if len(items) > 0 {
+		quoted := make([]string, len(items))
+		for i, item := range items {
+			quoted[i] = strconv.Quote(item)
+		}
+		return fmt.Sprintf("[%s]", strings.Join(quoted, ", "))
+	}
+
+	return "[]"
+}
+
+func renderListOfMapsOfListToString(input map[string][]string) string {
+	output := fmt.Sprintf("{\n")
+
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		output = fmt.Sprintf("%s    %s = %s\n", output, strconv.Quote(k), RenderListOfStrings(input[k]))
+	}
+
+	return fmt.Sprintf("%s  }", output)
+}
+
+func renderPolicyRule(rule *PolicyRule) string {
+	renderedRule := fmt.Sprintf("path %s {\n", strconv.Quote(rule.Path))
+	renderedRule = fmt.Sprintf("%s  capabilities = %s\n", renderedRule, RenderListOfStrings(rule.Capabilities))
+
+	if rule.Description != "" {
+		// every line of the description is commented out, so that it is
+		// parsed back as the lead comment of the path stanza.
+		var comment string
+		for _, line := range strings.Split(rule.Description, "\n") {
+			comment = fmt.Sprintf("%s# %s\n", comment, strings.TrimRight(line, "\r"))
+		}
+		renderedRule = comment + renderedRule
+	}
+
+	if len(rule.RequiredParameters) > 0 {
+		renderedRule = fmt.Sprintf("%s  required_parameters = %s\n", renderedRule, RenderListOfStrings(rule.RequiredParameters))
+	}
+
+	if len(rule.SubscribeEventTypes) > 0 {
+		renderedRule = fmt.Sprintf("%s  subscribe_event_types = %s\n", renderedRule, RenderListOfStrings(rule.SubscribeEventTypes))
+	}
+
+	if len(rule.AllowedParameters) > 0 {
+		renderedRule = fmt.Sprintf("%s  allowed_parameters = %s\n", renderedRule, renderListOfMapsOfListToString(rule.AllowedParameters))
+	}
+
+	if len(rule.DeniedParameters) > 0 {
+		renderedRule = fmt.Sprintf("%s  denied_parameters = %s\n", renderedRule, renderListOfMapsOfListToString(rule.DeniedParameters))
+	}
+
+	if rule.MinWrappingTTL != "" {
+		renderedRule = fmt.Sprintf("%s  min_wrapping_ttl = %s\n", renderedRule, strconv.Quote(rule.MinWrappingTTL))
+	}
+
+	if rule.MaxWrappingTTL != "" {
+		renderedRule = fmt.Sprintf("%s  max_wrapping_ttl = %s\n", renderedRule, strconv.Quote(rule.MaxWrappingTTL))
+	}
+
+	return fmt.Sprintf("%s}\n", renderedRule)
+}
@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		hcl     string
+		want    *Policy
+		wantErr bool
+	}{
+		{
+			name: "basic",
+			hcl: `
+# read secrets
+path "secret/data/*" {
+  capabilities = ["read", "list"]
+}
+
+path "sys/mounts" {
+  capabilities        = ["read"]
+  required_parameters = ["type"]
+  allowed_parameters = {
+    "type"    = ["kv", 2]
+    "options" = []
+  }
+  denied_parameters = {
+    "*" = []
+  }
+  min_wrapping_ttl = "1m"
+  max_wrapping_ttl = 3600
+}
+`,
+			want: &Policy{
+				Rules: []*PolicyRule{
+					{
+						Path:         "secret/data/*",
+						Description:  "read secrets",
+						Capabilities: []string{"read", "list"},
+					},
+					{
+						Path:               "sys/mounts",
+						Capabilities:       []string{"read"},
+						RequiredParameters: []string{"type"},
+						AllowedParameters: map[string][]string{
+							"type":    {"kv", "2"},
+							"options": {},
+						},
+						DeniedParameters: map[string][]string{
+							"*": {},
+						},
+						MinWrappingTTL: "1m",
+						MaxWrappingTTL: "3600",
+					},
+				},
+			},
+		},
+		{
+			name: "empty",
+			hcl:  "",
+			want: &Policy{},
+		},
+		{
+			name:    "invalid-capability",
+			hcl:     `path "secret/*" { capabilities = ["write"] }`,
+			wantErr: true,
+		},
+		{
+			name: "unsupported-key",
+			hcl: `
+path "secret/*" {
+  capabilities  = ["read"]
+  control_group = {
+    factor "approvers" {
+      identity {
+        group_names = ["admins"]
+      }
+    }
+  }
+}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid-hcl",
+			hcl:     `path "secret/*" {`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.hcl)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRenderParseRoundTrip(t *testing.T) {
+	p := &Policy{
+		Rules: []*PolicyRule{
+			{
+				Path:                "secret/test1/*",
+				Description:         "test rule 1",
+				Capabilities:        []string{"create", "read", "update", "delete", "list", "patch"},
+				RequiredParameters:  []string{"test_param1"},
+				SubscribeEventTypes: []string{"test_events1"},
+				AllowedParameters: map[string][]string{
+					"spam": {"eggs"},
+					"eggs": {"foo", "bar"},
+				},
+				DeniedParameters: map[string][]string{
+					"a": {"spam"},
+				},
+				MaxWrappingTTL: "1h",
+			},
+			{
+				Path:           "secret/test2/*",
+				Capabilities:   []string{"read"},
+				MinWrappingTTL: "1m",
+			},
+			{
+				Path:         `secret/"quoted"/*`,
+				Description:  "first line\nsecond line",
+				Capabilities: []string{"list"},
+				AllowedParameters: map[string][]string{
+					`"key"`: {`"value"`},
+				},
+			},
+		},
+	}
+
+	rendered := Render(p)
+
+	got, err := Parse(rendered)
+	require.NoError(t, err)
+	assert.Equal(t, p, got)
+	assert.Equal(t, rendered, Render(got))
+}
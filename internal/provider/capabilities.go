@@ -0,0 +1,424 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+// capabilityBatchWindow is how long the capability checker waits for other
+// planned resources before sending its batch to Vault.
+const capabilityBatchWindow = 100 * time.Millisecond
+
+var pathVariableRegex = regexp.MustCompile(`{([^}]+)}`)
+
+// pathVariableFields maps the variables used in a PathInventory to the
+// resource fields that may hold their value, in order of precedence.
+var pathVariableFields = map[string][]string{
+	"role":      {consts.FieldRole, consts.FieldRoleName, consts.FieldName},
+	"role_name": {consts.FieldRoleName, consts.FieldName},
+}
+
+// mountFields are the resource fields that may hold the mount path of a
+// resource, in order of precedence.
+var mountFields = []string{consts.FieldBackend, consts.FieldMount, consts.FieldPath}
+
+// CapabilityPathsFunc returns the Vault paths that the planned change of a
+// resource writes to. Paths that cannot be determined during plan, e.g.
+// because they depend on unknown values, must be omitted.
+type CapabilityPathsFunc func(d *schema.ResourceDiff) ([]string, error)
+
+// planValueGetter is implemented by schema.ResourceDiff.
+type planValueGetter interface {
+	Get(key string) interface{}
+	NewValueKnown(key string) bool
+}
+
+// capabilityChecker batches the sys/capabilities-self requests of the
+// resources that are planned concurrently, and caches their results for the
+// lifetime of the provider.
+type capabilityChecker struct {
+	mu      sync.Mutex
+	cache   map[string]map[string][]string
+	pending map[string]*capabilityBatch
+}
+
+type capabilityBatch struct {
+	paths  map[string]bool
+	done   chan struct{}
+	result map[string][]string
+	err    error
+}
+
+// addCapabilityChecks adds a CustomizeDiff to every resource in the registry
+// that checks the planned paths against the capabilities of the provider
+// token, when the plan_capability_check provider argument is enabled. The
+// paths derived from the PathInventory may not be the ones that the resource
+// writes to, so their missing capabilities are only logged as warnings.
+func addCapabilityChecks(registry ResourceRegistry) {
+	for name, desc := range registry {
+		r := desc.Resource
+		if r == nil {
+			continue
+		}
+
+		pathsFunc := desc.CapabilityPaths
+		strict := pathsFunc != nil
+		if pathsFunc == nil {
+			inventory := desc.PathInventory
+			s := r.Schema
+			pathsFunc = func(d *schema.ResourceDiff) ([]string, error) {
+				return inventoryPaths(inventory, s, d), nil
+			}
+		}
+
+		r.CustomizeDiff = capabilityCheckCustomizeDiff(name, pathsFunc, strict, r.CustomizeDiff)
+	}
+}
+
+func capabilityCheckCustomizeDiff(name string, pathsFunc CapabilityPathsFunc, strict bool, next schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if next != nil {
+			if err := next(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+
+		p, ok := meta.(*ProviderMeta)
+		if !ok || !p.PlanCapabilityCheck() {
+			return nil
+		}
+
+		create := d.Id() == ""
+		if !create && len(d.GetChangedKeysPrefix("")) == 0 {
+			return nil
+		}
+
+		paths, err := pathsFunc(d)
+		if err != nil {
+			return err
+		}
+
+		if len(paths) == 0 {
+			log.Printf("[DEBUG] No paths to check capabilities for on %q", name)
+			return nil
+		}
+
+		var ns string
+		if _, ok := d.GetOk(consts.FieldNamespace); ok {
+			if !d.NewValueKnown(consts.FieldNamespace) {
+				return nil
+			}
+			ns = d.Get(consts.FieldNamespace).(string)
+		}
+
+		missing, err := p.MissingCapabilities(ctx, ns, paths, create)
+		if err != nil {
+			return fmt.Errorf("failed to check the capabilities of the provider token, err=%w", err)
+		}
+
+		if len(missing) == 0 {
+			return nil
+		}
+
+		err = fmt.Errorf("the provider token lacks the capabilities to apply %s:\n  %s",
+			name, strings.Join(missing, "\n  "))
+		if !strict {
+			log.Printf("[WARN] %s", err)
+			return nil
+		}
+
+		return err
+	}
+}
+
+// PlanCapabilityCheck returns true if the plan_capability_check provider
+// argument is enabled.
+func (p *ProviderMeta) PlanCapabilityCheck() bool {
+	if p == nil || p.resourceData == nil {
+		return false
+	}
+
+	return GetResourceDataBool(p.resourceData, consts.FieldPlanCapabilityCheck, consts.EnvVarPlanCapabilityCheck, false)
+}
+
+// MissingCapabilities checks the capabilities of the provider token on the
+// paths in the namespace, and describes each path on which the token lacks
+// the capabilities to create or update, depending on create.
+func (p *ProviderMeta) MissingCapabilities(ctx context.Context, ns string, paths []string, create bool) ([]string, error) {
+	capabilities, err := p.checkCapabilities(ctx, ns, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []string
+	for _, path := range paths {
+		if caps, ok := missingCapabilities(capabilities[path], create); !ok {
+			missing = append(missing, fmt.Sprintf("%q requires one of %s, granted %s",
+				path, strings.Join(caps, ", "), formatCapabilities(capabilities[path])))
+		}
+	}
+
+	return missing, nil
+}
+
+// missingCapabilities returns the capabilities that are required for the
+// planned operation and whether any of them are granted. Writes to new paths
+// are accepted with either create or update, since backends without an
+// existence check always treat writes as updates.
+func missingCapabilities(granted []string, create bool) ([]string, bool) {
+	required := []string{"update", "root"}
+	if create {
+		required = []string{"create", "update", "root"}
+	}
+
+	for _, c := range required {
+		if slices.Contains(granted, c) {
+			return required, true
+		}
+	}
+
+	return required, false
+}
+
+func formatCapabilities(caps []string) string {
+	if len(caps) == 0 {
+		return "none"
+	}
+
+	return strings.Join(caps, ", ")
+}
+
+// inventoryPaths derives the Vault paths of a resource from its
+// PathInventory. The default mount of each path is replaced with the mount
+// that is configured on the resource, and the path variables are replaced
+// with the values of the matching resource fields. Paths that cannot be fully
+// determined, including paths on a mount that is not configured on the
+// resource, are omitted.
+func inventoryPaths(inventory []string, s map[string]*schema.Schema, d planValueGetter) []string {
+	getString := func(field string) (string, bool) {
+		if _, ok := s[field]; !ok || !d.NewValueKnown(field) {
+			return "", false
+		}
+		v, ok := d.Get(field).(string)
+		v = strings.Trim(v, "/")
+		return v, ok && v != ""
+	}
+
+	var paths []string
+	for _, p := range inventory {
+		p, _, _ = strings.Cut(p, "?")
+		p = strings.Trim(p, "/")
+		if !strings.Contains(p, "/") {
+			// not a path, e.g. the generic path
+			continue
+		}
+
+		variables := make(map[string]bool)
+		resolved := true
+		p = pathVariableRegex.ReplaceAllStringFunc(p, func(m string) string {
+			variable := strings.Trim(m, "{}")
+			variables[variable] = true
+			fields, ok := pathVariableFields[variable]
+			if !ok {
+				fields = []string{variable}
+			}
+			for _, field := range fields {
+				if v, ok := getString(field); ok {
+					return v
+				}
+			}
+
+			resolved = false
+			return m
+		})
+		if !resolved {
+			continue
+		}
+
+		segments := strings.SplitN(p, "/", 2)
+		auth := segments[0] == "auth"
+		if auth {
+			segments = strings.SplitN(segments[1], "/", 2)
+		}
+
+		if segments[0] != "sys" && segments[0] != "identity" {
+			var mounted bool
+			for _, field := range mountFields {
+				if variables[field] {
+					// the field is a path variable, not the mount
+					continue
+				}
+				if v, ok := getString(field); ok {
+					segments[0] = strings.TrimPrefix(v, "auth/")
+					mounted = true
+					break
+				}
+			}
+
+			if !mounted {
+				// the default mount in the inventory may not be the one
+				// that the resource writes to
+				continue
+			}
+		}
+
+		p = strings.Join(segments, "/")
+		if auth {
+			p = "auth/" + p
+		}
+
+		if !slices.Contains(paths, p) {
+			paths = append(paths, p)
+		}
+	}
+
+	return paths
+}
+
+// checkCapabilities returns the capabilities of the provider token on each of
+// the paths in the namespace. Paths that are checked concurrently by other
+// resources are sent to sys/capabilities-self in a single batch.
+func (p *ProviderMeta) checkCapabilities(ctx context.Context, ns string, paths []string) (map[string][]string, error) {
+	c := &p.capabilities
+
+	c.mu.Lock()
+	result := make(map[string][]string, len(paths))
+	var batch *capabilityBatch
+	for _, path := range paths {
+		if caps, ok := c.cache[ns][path]; ok {
+			result[path] = caps
+			continue
+		}
+
+		if batch == nil {
+			batch = c.pendingBatch(p, ns)
+		}
+		batch.paths[path] = true
+	}
+	c.mu.Unlock()
+
+	if batch == nil {
+		return result, nil
+	}
+
+	select {
+	case <-batch.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if batch.err != nil {
+		return nil, batch.err
+	}
+
+	for _, path := range paths {
+		if _, ok := result[path]; !ok {
+			result[path] = batch.result[path]
+		}
+	}
+
+	return result, nil
+}
+
+// pendingBatch returns the batch of the namespace that has yet to be sent,
+// starting a new one if needed. Must be called with a lock.
+func (c *capabilityChecker) pendingBatch(p *ProviderMeta, ns string) *capabilityBatch {
+	if batch, ok := c.pending[ns]; ok {
+		return batch
+	}
+
+	if c.pending == nil {
+		c.pending = make(map[string]*capabilityBatch)
+	}
+
+	batch := &capabilityBatch{
+		paths: make(map[string]bool),
+		done:  make(chan struct{}),
+	}
+	c.pending[ns] = batch
+
+	go func() {
+		time.Sleep(capabilityBatchWindow)
+
+		c.mu.Lock()
+		delete(c.pending, ns)
+		c.mu.Unlock()
+
+		batch.result, batch.err = p.readCapabilities(ns, batch.paths)
+		if batch.err == nil {
+			c.mu.Lock()
+			if c.cache == nil {
+				c.cache = make(map[string]map[string][]string)
+			}
+			if c.cache[ns] == nil {
+				c.cache[ns] = make(map[string][]string)
+			}
+			for path, caps := range batch.result {
+				c.cache[ns][path] = caps
+			}
+			c.mu.Unlock()
+		}
+
+		close(batch.done)
+	}()
+
+	return batch
+}
+
+func (p *ProviderMeta) readCapabilities(ns string, pathSet map[string]bool) (map[string][]string, error) {
+	client, err := p.GetClient()
+	if err != nil {
+		return nil, err
+	}
+
+	if ns != "" {
+		client, err = p.GetNSClient(ns)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	paths := make([]string, 0, len(pathSet))
+	for path := range pathSet {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	log.Printf("[DEBUG] Checking the capabilities of the provider token on %d paths, namespace=%q", len(paths), ns)
+	resp, err := client.Logical().Write("sys/capabilities-self", map[string]interface{}{
+		"paths": paths,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]string, len(paths))
+	for _, path := range paths {
+		result[path] = []string{}
+		if resp == nil {
+			continue
+		}
+
+		if v, ok := resp.Data[path].([]interface{}); ok {
+			for _, c := range v {
+				result[path] = append(result[path], c.(string))
+			}
+		}
+	}
+
+	return result, nil
+}
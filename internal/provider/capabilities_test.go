@@ -0,0 +1,268 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+type testPlanValues map[string]interface{}
+
+func (v testPlanValues) Get(key string) interface{} {
+	if v[key] == nil {
+		return ""
+	}
+	return v[key]
+}
+
+func (v testPlanValues) NewValueKnown(key string) bool {
+	return v[key] != nil
+}
+
+func TestInventoryPaths(t *testing.T) {
+	tests := []struct {
+		name      string
+		inventory []string
+		fields    []string
+		values    testPlanValues
+		want      []string
+	}{
+		{
+			name:      "backend",
+			inventory: []string{"/aws/roles/{name}"},
+			fields:    []string{consts.FieldBackend, consts.FieldName},
+			values: testPlanValues{
+				consts.FieldBackend: "aws-prod/",
+				consts.FieldName:    "deploy",
+			},
+			want: []string{"aws-prod/roles/deploy"},
+		},
+		{
+			name:      "auth-backend",
+			inventory: []string{"/auth/jwt/role/{role_name}"},
+			fields:    []string{consts.FieldBackend, consts.FieldRoleName},
+			values: testPlanValues{
+				consts.FieldBackend:  "oidc",
+				consts.FieldRoleName: "dev",
+			},
+			want: []string{"auth/oidc/role/dev"},
+		},
+		{
+			name:      "auth-path",
+			inventory: []string{"/auth/jwt/config"},
+			fields:    []string{consts.FieldPath},
+			values: testPlanValues{
+				consts.FieldPath: "auth/jwt-prod",
+			},
+			want: []string{"auth/jwt-prod/config"},
+		},
+		{
+			name:      "sys",
+			inventory: []string{"/sys/mounts/{path}"},
+			fields:    []string{consts.FieldPath},
+			values: testPlanValues{
+				consts.FieldPath: "kv",
+			},
+			want: []string{"sys/mounts/kv"},
+		},
+		{
+			name:      "role-alias",
+			inventory: []string{"/ssh/roles/{role}"},
+			fields:    []string{consts.FieldBackend, consts.FieldName},
+			values: testPlanValues{
+				consts.FieldBackend: "ssh",
+				consts.FieldName:    "admin",
+			},
+			want: []string{"ssh/roles/admin"},
+		},
+		{
+			name:      "unknown-variable",
+			inventory: []string{"/aws/roles/{name}"},
+			fields:    []string{consts.FieldBackend, consts.FieldName},
+			values: testPlanValues{
+				consts.FieldBackend: "aws",
+			},
+		},
+		{
+			name:      "no-mount",
+			inventory: []string{"/secret/{path}"},
+			fields:    []string{consts.FieldPath},
+			values: testPlanValues{
+				consts.FieldPath: "secret/foo",
+			},
+		},
+		{
+			name:      "generic",
+			inventory: []string{"generic"},
+			fields:    []string{consts.FieldPath},
+			values: testPlanValues{
+				consts.FieldPath: "secret/foo",
+			},
+		},
+		{
+			name:      "query",
+			inventory: []string{"/sys/policy/{name}/?list=true"},
+			fields:    []string{consts.FieldName},
+			values: testPlanValues{
+				consts.FieldName: "admin",
+			},
+			want: []string{"sys/policy/admin"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := make(map[string]*schema.Schema)
+			for _, f := range tt.fields {
+				s[f] = &schema.Schema{Type: schema.TypeString}
+			}
+
+			assert.Equal(t, tt.want, inventoryPaths(tt.inventory, s, tt.values))
+		})
+	}
+}
+
+func TestMissingCapabilities(t *testing.T) {
+	_, ok := missingCapabilities([]string{"read", "create"}, true)
+	assert.True(t, ok)
+
+	_, ok = missingCapabilities([]string{"read", "create"}, false)
+	assert.False(t, ok)
+
+	_, ok = missingCapabilities([]string{"root"}, false)
+	assert.True(t, ok)
+
+	required, ok := missingCapabilities(nil, true)
+	assert.False(t, ok)
+	assert.Equal(t, []string{"create", "update", "root"}, required)
+}
+
+func TestProviderMeta_checkCapabilities(t *testing.T) {
+	var requests atomic.Int32
+	var requested []string
+	var m sync.Mutex
+
+	mockVaultHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/auth/token/lookup-self":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": map[string]interface{}{
+					"id":       "test-token",
+					"policies": []string{"default"},
+					"ttl":      0,
+				},
+			})
+			return
+		case "/v1/auth/token/create":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"auth": map[string]interface{}{
+					"client_token":   "child-token",
+					"policies":       []string{"default"},
+					"lease_duration": 3600,
+				},
+			})
+			return
+		case "/v1/sys/capabilities-self":
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+
+		requests.Add(1)
+
+		var body struct {
+			Paths []string `json:"paths"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		m.Lock()
+		requested = append(requested, body.Paths...)
+		m.Unlock()
+
+		data := map[string]interface{}{}
+		for _, p := range body.Paths {
+			data[p] = []string{"read"}
+		}
+		data["secret/writable"] = []string{"create", "update"}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": data,
+		})
+	})
+
+	config, ln := testutil.TestHTTPServer(t, mockVaultHandler)
+	defer ln.Close()
+
+	p := &ProviderMeta{
+		resourceData: schema.TestResourceDataRaw(t,
+			map[string]*schema.Schema{
+				consts.FieldAddress: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				consts.FieldToken: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				consts.FieldNamespace: {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+			map[string]interface{}{
+				consts.FieldAddress: config.Address,
+				consts.FieldToken:   "test-token",
+			},
+		),
+	}
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	results := make([]map[string][]string, 3)
+	for i, paths := range [][]string{
+		{"secret/writable"},
+		{"secret/readonly"},
+		{"secret/writable", "sys/policy/admin"},
+	} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := p.checkCapabilities(ctx, "", paths)
+			if assert.NoError(t, err) {
+				results[i] = result
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), requests.Load(), "expected a single batch")
+	assert.ElementsMatch(t, []string{"secret/readonly", "secret/writable", "sys/policy/admin"}, requested)
+	assert.Equal(t, map[string][]string{"secret/writable": {"create", "update"}}, results[0])
+	assert.Equal(t, map[string][]string{"secret/readonly": {"read"}}, results[1])
+
+	// cached paths are not requested again
+	result, err := p.checkCapabilities(ctx, "", []string{"secret/readonly"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"secret/readonly": {"read"}}, result)
+	assert.Equal(t, int32(1), requests.Load())
+
+	missing, err := p.MissingCapabilities(ctx, "", []string{"secret/writable", "secret/readonly"}, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{`"secret/readonly" requires one of create, update, root, granted read`}, missing)
+}
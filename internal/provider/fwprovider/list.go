@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// sdkv2Provider is implemented by the primary provider, it gives list
+// resources access to the SDKv2 managed resources that they list.
+type sdkv2Provider interface {
+	SchemaProvider() *schema.Provider
+	GRPCProvider() tfprotov5.ProviderServer
+}
+
+// sdkv2ListResource is implemented by list resources that embed
+// base.SDKv2ListResource.
+type sdkv2ListResource interface {
+	SetSDKv2Resource(*schema.Resource, *tfprotov5.Schema, *tfprotov5.ResourceIdentitySchema)
+}
+
+// withSDKv2Resources wraps the list resource constructors so that list
+// resources for SDKv2 managed resources are given the managed resource and its
+// protocol schemas on instantiation.
+func withSDKv2Resources(ctx context.Context, primary interface{ Meta() interface{} }, fs []func() list.ListResource) []func() list.ListResource {
+	p, ok := primary.(sdkv2Provider)
+	if !ok {
+		return fs
+	}
+
+	server := p.GRPCProvider()
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		log.Printf("[WARN] Failed to get SDKv2 provider schema for list resources: %s", err)
+		return fs
+	}
+
+	identities, err := server.GetResourceIdentitySchemas(ctx, &tfprotov5.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		log.Printf("[WARN] Failed to get SDKv2 identity schemas for list resources: %s", err)
+		return fs
+	}
+
+	result := make([]func() list.ListResource, 0, len(fs))
+	for _, f := range fs {
+		result = append(result, func() list.ListResource {
+			r := f()
+			v, ok := r.(sdkv2ListResource)
+			if !ok {
+				return r
+			}
+
+			var resp resource.MetadataResponse
+			r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "vault"}, &resp)
+
+			v.SetSDKv2Resource(
+				p.SchemaProvider().ResourcesMap[resp.TypeName],
+				schemas.ResourceSchemas[resp.TypeName],
+				identities.IdentitySchemas[resp.TypeName],
+			)
+
+			return r
+		})
+	}
+
+	return result
+}
@@ -10,8 +10,11 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -25,15 +28,22 @@ import (
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/auth/spiffe"
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/auth/userpass"
 	ephemeralgeneric "github.com/hashicorp/terraform-provider-vault/internal/vault/generic"
+	"github.com/hashicorp/terraform-provider-vault/internal/vault/identity"
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/keymgmt"
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/alicloud"
+	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/aws"
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/azure"
+	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/database"
 	ephemeralsecrets "github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/ephemeral"
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/gcpkms"
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/kmip"
+	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/kv"
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/os"
+	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/pki"
 	pki_external_ca "github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/pki-external-ca"
 	spiffesec "github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/spiffe"
+	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/ssh"
+	"github.com/hashicorp/terraform-provider-vault/internal/vault/secrets/transit"
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/sys"
 	"github.com/hashicorp/terraform-provider-vault/internal/vault/sys/config"
 	sysconfig "github.com/hashicorp/terraform-provider-vault/internal/vault/sys/config"
@@ -41,6 +51,12 @@ import (
 
 var _ provider.ProviderWithEphemeralResources = &fwprovider{}
 
+var _ provider.ProviderWithFunctions = &fwprovider{}
+
+var _ provider.ProviderWithListResources = &fwprovider{}
+
+var _ provider.ProviderWithActions = &fwprovider{}
+
 // Ensure the implementation satisfies the provider.Provider interface
 var _ provider.Provider = &fwprovider{}
 
@@ -103,6 +119,16 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				// Note that this is strongly discouraged due to the potential of exposing sensitive secret data.
 				Description: "Set this to true to prevent the creation of ephemeral child token used by this provider.",
 			},
+			consts.FieldTokenAutoRenew: schema.BoolAttribute{
+				Optional: true,
+				Description: "Set this to true to renew the provider's token in the background, " +
+					"and to re-authenticate once it can no longer be renewed.",
+			},
+			consts.FieldPlanCapabilityCheck: schema.BoolAttribute{
+				Optional: true,
+				Description: "Set this to true to check the capabilities of the provider's token " +
+					"on the paths of the planned resources during plan.",
+			},
 			consts.FieldCACertFile: schema.StringAttribute{
 				Optional:    true,
 				Description: "Path to a CA certificate file to validate the server's certificate.",
@@ -131,6 +157,18 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Maximum number of retries for Client Controlled Consistency related operations",
 			},
+			consts.FieldMaxRetriesRateLimit: schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of retries when a 429 error code is encountered.",
+			},
+			consts.FieldRateLimit: schema.Float64Attribute{
+				Optional:    true,
+				Description: "Maximum number of requests per second sent to Vault.",
+			},
+			consts.FieldMaxConcurrentRequests: schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of concurrent requests sent to Vault.",
+			},
 			consts.FieldNamespace: schema.StringAttribute{
 				Optional:    true,
 				Description: "The namespace to use. Available only for Vault Enterprise.",
@@ -230,6 +268,8 @@ func (p *fwprovider) Configure(ctx context.Context, req provider.ConfigureReques
 	resp.DataSourceData = v
 	resp.ResourceData = v
 	resp.EphemeralResourceData = v
+	resp.ListResourceData = v
+	resp.ActionData = v
 }
 
 // Resources returns a slice of functions to instantiate each Resource
@@ -287,6 +327,9 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 		kerberosauth.NewKerberosAuthBackendConfigResource,
 		kerberosauth.NewKerberosAuthBackendLDAPConfigResource,
 		kerberosauth.NewKerberosAuthBackendGroupResource,
+		kv.NewKVSecretsV2Resource,
+		ssh.NewSSHSecretBackendZeroAddressResource,
+		sys.NewNamespaceLockResource,
 	}, testResources()...)
 }
 
@@ -315,7 +358,19 @@ func (p *fwprovider) EphemeralResources(_ context.Context) []func() ephemeral.Ep
 		gcpkms.NewGCPKMSDecryptEphemeralResource,
 		gcpkms.NewGCPKMSReencryptEphemeralResource,
 		gcpkms.NewGCPKMSSignEphemeralResource,
+		transit.NewTransitEncryptEphemeralResource,
+		transit.NewTransitDecryptEphemeralResource,
+		transit.NewTransitRewrapEphemeralResource,
+		transit.NewTransitDataKeyEphemeralResource,
+		pki.NewPKISecretBackendCertEphemeralResource,
+		ssh.NewSSHSecretBackendSignEphemeralResource,
+		ssh.NewSSHSecretBackendCredsEphemeralResource,
+		ssh.NewSSHSecretBackendVerifyEphemeralResource,
 		kerberosauth.NewKerberosAuthBackendLoginEphemeralResource,
+		sys.NewUnwrapEphemeralResource,
+		sys.NewAuditHashEphemeralResource,
+		identity.NewOIDCTokenEphemeralResource,
+		identity.NewOIDCIntrospectEphemeralResource,
 	}
 }
 
@@ -331,5 +386,58 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 		gcpkms.NewGCPKMSVerifyDataSource,
 		sys.NewPluginRuntimesDataSource,
 		config.NewSysConfigCORSDataSource,
+		sys.NewWrappingLookupDataSource,
+		sys.NewSecretsSyncDestinationsDataSource,
+		sys.NewAuditDevicesDataSource,
+	}
+}
+
+// Functions returns a slice of functions to instantiate each provider-defined
+// Function implementation.
+//
+// The function name is determined by the Function implementing the Metadata
+// method. All functions must have unique names.
+func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		sys.NewPolicyDecodeFunction,
+		sys.NewPolicyEncodeFunction,
+	}
+}
+
+// ListResources returns a slice of functions to instantiate each ListResource
+// implementation.
+//
+// The list resource type name is determined by the ListResource implementing
+// the Metadata method. Each list resource must have the same type name as the
+// managed resource that it lists.
+func (p *fwprovider) ListResources(ctx context.Context) []func() list.ListResource {
+	return withSDKv2Resources(ctx, p.Primary, []func() list.ListResource{
+		sys.NewMountListResource,
+		sys.NewAuthBackendListResource,
+		sys.NewPolicyListResource,
+		identity.NewEntityListResource,
+		identity.NewGroupListResource,
+		kv.NewKVSecretV2ListResource,
+		database.NewDatabaseSecretBackendRoleListResource,
+		pki.NewPKISecretBackendRoleListResource,
+		aws.NewAWSSecretBackendRoleListResource,
+	})
+}
+
+// Actions returns a slice of functions to instantiate each Action
+// implementation.
+//
+// The action type name is determined by the Action implementing the Metadata
+// method. All actions must have unique names.
+func (p *fwprovider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		database.NewDatabaseSecretBackendRotateRootAction,
+		aws.NewAWSSecretBackendRotateRootAction,
+		pki.NewPKISecretBackendTidyAction,
+		transit.NewTransitKeyRotateAction,
+		keymgmt.NewKeyRotateAction,
+		sys.NewLeaseRevokePrefixAction,
+		sys.NewRaftSnapshotAction,
+		sys.NewRaftSnapshotRestoreAction,
 	}
 }
@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+)
+
+// GetImportByIDIdentitySchema returns the resource identity schema of
+// resources that are imported by their "id". The identity carries the
+// namespace along with the ID, so that an object discovered by a list
+// resource can be imported as-is, without setting
+// TERRAFORM_VAULT_NAMESPACE_IMPORT.
+func GetImportByIDIdentitySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.FieldID: {
+			Type:              schema.TypeString,
+			RequiredForImport: true,
+			Description:       "The ID of the resource, as accepted by terraform import.",
+		},
+		consts.FieldNamespace: {
+			Type:              schema.TypeString,
+			OptionalForImport: true,
+			Description:       "Target namespace. (requires Enterprise)",
+		},
+	}
+}
+
+// MustAddImportByIDIdentity adds the GetImportByIDIdentitySchema resource
+// identity to r. The resource's create, read and update functions are wrapped
+// to record the identity, and its importer is wrapped to accept an identity in
+// place of an import ID.
+//
+// This is the SDKv2 counterpart of the framework's base.WithImportByID.
+func MustAddImportByIDIdentity(r *schema.Resource) *schema.Resource {
+	if r.Identity != nil {
+		panic("cannot add import by ID identity, resource already has an identity")
+	}
+	if r.Importer == nil {
+		panic("cannot add import by ID identity, resource does not support import")
+	}
+
+	r.Identity = &schema.ResourceIdentity{
+		SchemaFunc: GetImportByIDIdentitySchema,
+	}
+	// some resources are moved in place, e.g. on remount, which changes their ID.
+	r.ResourceBehavior.MutableIdentity = true
+
+	r.Create = identityWrapper(r.Create)
+	r.CreateContext = identityContextWrapper(r.CreateContext)
+	r.CreateWithoutTimeout = identityContextWrapper(r.CreateWithoutTimeout)
+	r.Read = identityWrapper(r.Read)
+	r.ReadContext = identityContextWrapper(r.ReadContext)
+	r.ReadWithoutTimeout = identityContextWrapper(r.ReadWithoutTimeout)
+	r.Update = identityWrapper(r.Update)
+	r.UpdateContext = identityContextWrapper(r.UpdateContext)
+	r.UpdateWithoutTimeout = identityContextWrapper(r.UpdateWithoutTimeout)
+
+	importer := r.Importer
+	r.Importer = &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if err := importByIDIdentity(d); err != nil {
+				return nil, err
+			}
+
+			switch {
+			case importer.StateContext != nil:
+				return importer.StateContext(ctx, d, meta)
+			case importer.State != nil:
+				return importer.State(d, meta)
+			default:
+				return []*schema.ResourceData{d}, nil
+			}
+		},
+	}
+
+	return r
+}
+
+func identityWrapper[F ~func(*schema.ResourceData, interface{}) error](f F) F {
+	if f == nil {
+		return nil
+	}
+
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if err := f(d, meta); err != nil {
+			return err
+		}
+
+		return setImportByIDIdentity(d)
+	}
+}
+
+func identityContextWrapper[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](f F) F {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := f(ctx, d, meta)
+		if diags.HasError() {
+			return diags
+		}
+
+		if err := setImportByIDIdentity(d); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		return diags
+	}
+}
+
+func setImportByIDIdentity(d *schema.ResourceData) error {
+	// the resource was removed out of band
+	if d.Id() == "" {
+		return nil
+	}
+
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+
+	if err := identity.Set(consts.FieldID, d.Id()); err != nil {
+		return fmt.Errorf("error setting identity %q: %w", consts.FieldID, err)
+	}
+
+	if ns, ok := d.GetOk(consts.FieldNamespace); ok {
+		if err := identity.Set(consts.FieldNamespace, ns); err != nil {
+			return fmt.Errorf("error setting identity %q: %w", consts.FieldNamespace, err)
+		}
+	}
+
+	return nil
+}
+
+// importByIDIdentity sets the ID and namespace from the resource identity when
+// the resource is imported by identity rather than by ID.
+func importByIDIdentity(d *schema.ResourceData) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	identity, err := d.Identity()
+	if err != nil {
+		return fmt.Errorf("error getting identity: %w", err)
+	}
+
+	id, ok := identity.GetOk(consts.FieldID)
+	if !ok {
+		return fmt.Errorf("expected identity to contain key %q", consts.FieldID)
+	}
+	d.SetId(id.(string))
+
+	if ns, ok := identity.GetOk(consts.FieldNamespace); ok {
+		if err := d.Set(consts.FieldNamespace, ns); err != nil {
+			return fmt.Errorf("failed to import %q from identity, err=%w", consts.FieldNamespace, err)
+		}
+	}
+
+	return nil
+}
@@ -70,6 +70,12 @@ type ProviderMeta struct {
 	resourceData *schema.ResourceData
 	clientCache  map[string]*api.Client
 	vaultVersion *version.Version
+	// limiter is shared by all clients, so that the limits still apply after
+	// re-authenticating.
+	limiter *helper.RequestLimiter
+	// capabilities caches the capabilities of the provider token during
+	// plan, see plan_capability_check.
+	capabilities capabilityChecker
 	mu           sync.RWMutex
 }
 
@@ -200,12 +206,44 @@ func (p *ProviderMeta) setClient() error {
 		return fmt.Errorf("nil ResourceData provided")
 	}
 
+	if p.limiter == nil {
+		p.limiter = helper.NewRequestLimiter(
+			GetResourceDataFloat(p.resourceData, consts.FieldRateLimit, consts.EnvVarRateLimit, 0),
+			GetResourceDataInt(p.resourceData, consts.FieldMaxConcurrentRequests, consts.EnvVarMaxConcurrentRequests, 0),
+		)
+	}
+
+	client, namespace, err := p.newClient()
+	if err != nil {
+		return err
+	}
+
+	if namespace != "" {
+		if err := p.resourceData.Set(consts.FieldNamespace, namespace); err != nil {
+			return fmt.Errorf("failed to set namespace on provider: %w", err)
+		}
+	}
+
+	p.client = client
+
+	if GetResourceDataBool(p.resourceData, consts.FieldTokenAutoRenew, consts.EnvVarTokenAutoRenew, false) {
+		return p.watchToken(client)
+	}
+
+	return nil
+}
+
+// newClient returns a new Vault client that is authenticated with the
+// ProviderMeta.resourceData configuration, and the namespace of the client. It
+// does not modify the ProviderMeta, so once the limiter is set it can be
+// called without a lock.
+func (p *ProviderMeta) newClient() (*api.Client, string, error) {
 	d := p.resourceData
 	clientConfig := api.DefaultConfig()
 
 	addr := GetResourceDataStr(d, consts.FieldAddress, api.EnvVaultAddress, "")
 	if addr == "" {
-		return fmt.Errorf("failed to configure Vault address")
+		return nil, "", fmt.Errorf("failed to configure Vault address")
 	}
 	clientConfig.Address = addr
 
@@ -227,15 +265,26 @@ func (p *ProviderMeta) setClient() error {
 
 	err := clientConfig.ConfigureTLS(tlsConfig)
 	if err != nil {
-		return fmt.Errorf("failed to configure TLS for Vault API: %s", err)
+		return nil, "", fmt.Errorf("failed to configure TLS for Vault API: %s", err)
 	}
 
+	transportOptions := helper.DefaultTransportOptions()
+	transportOptions.Limiter = p.limiter
+	transportOptions.MaxRetriesRateLimit = GetResourceDataInt(
+		d, consts.FieldMaxRetriesRateLimit, consts.EnvVarMaxRetriesRateLimit, 0)
+
 	clientConfig.HttpClient.Transport = helper.NewTransport(
 		"Vault",
 		clientConfig.HttpClient.Transport,
-		helper.DefaultTransportOptions(),
+		transportOptions,
 	)
 
+	if transportOptions.MaxRetriesRateLimit > 0 {
+		// rate limited requests are retried by the transport, retrying them in
+		// the client as well would multiply the number of retries.
+		clientConfig.CheckRetry = helper.SkipRateLimitRetry(api.DefaultRetryPolicy)
+	}
+
 	// enable ReadYourWrites to support read-after-write on Vault Enterprise
 	clientConfig.ReadYourWrites = true
 
@@ -244,7 +293,7 @@ func (p *ProviderMeta) setClient() error {
 
 	client, err := api.NewClient(clientConfig)
 	if err != nil {
-		return fmt.Errorf("failed to configure Vault API: %s", err)
+		return nil, "", fmt.Errorf("failed to configure Vault API: %s", err)
 	}
 
 	// setting this is critical for proper namespace handling
@@ -280,7 +329,7 @@ func (p *ProviderMeta) setClient() error {
 
 	authLogin, err := GetAuthLogin(d)
 	if err != nil {
-		return err
+		return nil, "", err
 	}
 
 	var token string
@@ -288,7 +337,7 @@ func (p *ProviderMeta) setClient() error {
 		// the clone is only used to auth to Vault
 		clone, err := client.Clone()
 		if err != nil {
-			return err
+			return nil, "", err
 		}
 
 		if clone.Token() != "" {
@@ -310,7 +359,7 @@ func (p *ProviderMeta) setClient() error {
 
 		secret, err := authLogin.Login(clone)
 		if err != nil {
-			return err
+			return nil, "", err
 		}
 
 		token = secret.Auth.ClientToken
@@ -318,7 +367,7 @@ func (p *ProviderMeta) setClient() error {
 		// try and get the token from the config or token helper
 		token, err = GetToken(d)
 		if err != nil {
-			return err
+			return nil, "", err
 		}
 	}
 
@@ -327,15 +376,15 @@ func (p *ProviderMeta) setClient() error {
 	}
 
 	if client.Token() == "" {
-		return errors.New("no vault token set on Client")
+		return nil, "", errors.New("no vault token set on Client")
 	}
 
 	tokenInfo, err := client.Auth().Token().LookupSelf()
 	if err != nil {
-		return fmt.Errorf("failed to lookup token, err=%w", err)
+		return nil, "", fmt.Errorf("failed to lookup token, err=%w", err)
 	}
 	if tokenInfo == nil {
-		return fmt.Errorf("no token information returned from self lookup")
+		return nil, "", fmt.Errorf("no token information returned from self lookup")
 	}
 
 	warnMinTokenTTL(tokenInfo)
@@ -350,7 +399,7 @@ func (p *ProviderMeta) setClient() error {
 		// a child token is always created in the namespace of the parent token.
 		token, err = createChildToken(d, client, tokenNamespace)
 		if err != nil {
-			return err
+			return nil, "", err
 		}
 
 		client.SetToken(token)
@@ -377,15 +426,11 @@ func (p *ProviderMeta) setClient() error {
 		// This block executes when the namespace was explicitly
 		// configured on the provider (not derived from the token)
 		// or when the namespace was not configured on the provider but was derived from the token
-		if err := d.Set(consts.FieldNamespace, namespace); err != nil {
-			return fmt.Errorf("failed to set namespace on provider: %w", err)
-		}
 		log.Printf("[DEBUG] Setting namespace on client to %q", namespace)
 		client.SetNamespace(namespace)
 	}
 
-	p.client = client
-	return nil
+	return client, namespace, nil
 }
 
 func (p *ProviderMeta) setVaultVersion() error {
@@ -667,6 +712,25 @@ func GetResourceDataInt(d *schema.ResourceData, field, env string, dv int) int {
 	return dv
 }
 
+// GetResourceDataFloat returns the value for a given ResourceData field
+// If the value is the zero value, then it checks the environment variable. If
+// the environment variable is empty, the default dv is returned
+func GetResourceDataFloat(d *schema.ResourceData, field, env string, dv float64) float64 {
+	if v, ok := d.Get(field).(float64); ok && v != 0 {
+		return v
+	}
+	if env != "" {
+		if s := os.Getenv(env); s != "" {
+			ret, err := strconv.ParseFloat(s, 64)
+			if err == nil {
+				return ret
+			}
+		}
+	}
+	// return default
+	return dv
+}
+
 // GetResourceDataBool returns the value for a given ResourceData field
 // If the value is the zero value, then it checks the environment variable. If
 // the environment variable is empty, the default dv is returned
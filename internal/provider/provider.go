@@ -37,6 +37,11 @@ type Description struct {
 	// EnterpriseOnly defaults to false, but should be marked true if a resource is enterprise only.
 	EnterpriseOnly bool
 
+	// CapabilityPaths returns the paths that are checked against the
+	// capabilities of the provider token during plan. It is only needed when
+	// the paths cannot be derived from the PathInventory.
+	CapabilityPaths CapabilityPathsFunc
+
 	Resource *schema.Resource
 }
 
@@ -59,6 +64,8 @@ func NewProvider(
 		panic(err)
 	}
 
+	addCapabilityChecks(resourceRegistry)
+
 	for _, m := range extraResourcesMaps {
 		MustAddSchemaResource(m, coreResourcesMap, nil)
 	}
@@ -98,6 +105,18 @@ func NewProvider(
 				// Note that this is strongly discouraged due to the potential of exposing sensitive secret data.
 				Description: "Set this to true to prevent the creation of ephemeral child token used by this provider.",
 			},
+			consts.FieldTokenAutoRenew: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Set this to true to renew the provider's token in the background, " +
+					"and to re-authenticate once it can no longer be renewed.",
+			},
+			consts.FieldPlanCapabilityCheck: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Set this to true to check the capabilities of the provider's token " +
+					"on the paths of the planned resources during plan.",
+			},
 			consts.FieldCACertFile: {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -133,6 +152,21 @@ func NewProvider(
 				Optional:    true,
 				Description: "Maximum number of retries for Client Controlled Consistency related operations",
 			},
+			consts.FieldMaxRetriesRateLimit: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum number of retries when a 429 error code is encountered.",
+			},
+			consts.FieldRateLimit: {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Description: "Maximum number of requests per second sent to Vault.",
+			},
+			consts.FieldMaxConcurrentRequests: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum number of concurrent requests sent to Vault.",
+			},
 			consts.FieldNamespace: {
 				Type:        schema.TypeString,
 				Optional:    true,
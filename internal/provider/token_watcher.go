@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	// tokenReauthInitialInterval and tokenReauthMaxInterval bound the
+	// exponential backoff between failed re-authentication attempts.
+	tokenReauthInitialInterval = time.Second
+	tokenReauthMaxInterval     = 30 * time.Second
+)
+
+// watchToken starts a LifetimeWatcher that renews the client's token for as
+// long as possible, like the Vault Agent does. Once the token can no longer be
+// renewed the provider re-authenticates and swaps its client, retrying until
+// the token expires. Tokens without a TTL, e.g. root tokens, are not watched.
+func (p *ProviderMeta) watchToken(client *api.Client) error {
+	tokenInfo, err := client.Auth().Token().LookupSelf()
+	if err != nil {
+		return fmt.Errorf("failed to lookup token, err=%w", err)
+	}
+	if tokenInfo == nil {
+		return fmt.Errorf("no token information returned from self lookup")
+	}
+
+	ttl, err := tokenInfo.TokenTTL()
+	if err != nil {
+		return err
+	}
+
+	if ttl == 0 {
+		log.Printf("[DEBUG] The token has no TTL, it does not need to be renewed")
+		return nil
+	}
+
+	renewable, err := tokenInfo.TokenIsRenewable()
+	if err != nil {
+		return err
+	}
+
+	watcher, err := client.NewLifetimeWatcher(&api.LifetimeWatcherInput{
+		Secret: &api.Secret{
+			Auth: &api.SecretAuth{
+				ClientToken:   client.Token(),
+				Renewable:     renewable,
+				LeaseDuration: int(ttl.Seconds()),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create token lifetime watcher, err=%w", err)
+	}
+
+	log.Printf("[DEBUG] Watching token lifetime, ttl=%s, renewable=%t", ttl, renewable)
+
+	go watcher.Start()
+	go p.handleTokenLifetime(watcher, time.Now().Add(ttl))
+
+	return nil
+}
+
+// handleTokenLifetime waits for the watcher to give up on renewing the token
+// and then re-authenticates.
+func (p *ProviderMeta) handleTokenLifetime(watcher *api.LifetimeWatcher, expiresAt time.Time) {
+	defer watcher.Stop()
+
+	for {
+		select {
+		case err := <-watcher.DoneCh():
+			if err != nil {
+				log.Printf("[WARN] Failed to renew token, err=%s", err)
+			}
+
+			log.Printf("[INFO] Token can no longer be renewed, re-authenticating")
+			if err := p.reauthenticate(expiresAt); err != nil {
+				log.Printf("[ERROR] Failed to re-authenticate, err=%s", err)
+			}
+
+			return
+		case renewal := <-watcher.RenewCh():
+			log.Printf("[DEBUG] Renewed token at %s", renewal.RenewedAt)
+			if renewal.Secret != nil && renewal.Secret.Auth != nil {
+				expiresAt = renewal.RenewedAt.Add(time.Duration(renewal.Secret.Auth.LeaseDuration) * time.Second)
+			}
+		}
+	}
+}
+
+// reauthenticate replaces the provider's client with a newly authenticated
+// client, retrying with an exponential backoff until the token of the old
+// client expires. The token of the old client is left to expire, since
+// revoking it would also revoke its leases and fail the requests that still
+// use the old client.
+func (p *ProviderMeta) reauthenticate(expiresAt time.Time) error {
+	bo := backoff.NewExponentialBackOff()
+	bo.InitialInterval = tokenReauthInitialInterval
+	bo.MaxInterval = tokenReauthMaxInterval
+	// a zero MaxElapsedTime would retry forever, so an expired token is
+	// given a single attempt.
+	bo.MaxElapsedTime = max(time.Until(expiresAt), time.Nanosecond)
+
+	return backoff.RetryNotify(p.swapClient, bo, func(err error, d time.Duration) {
+		log.Printf("[WARN] Failed to re-authenticate, retrying in %s, err=%s", d, err)
+	})
+}
+
+// swapClient replaces the provider's client with a newly authenticated
+// client. The namespaced clients are cleared, so that they are cloned from the
+// new client on their next use. The lock is only held to swap the client, so
+// that the login does not block the requests that use the old client.
+func (p *ProviderMeta) swapClient() error {
+	client, _, err := p.newClient()
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	p.client = client
+	p.clientCache = nil
+	p.mu.Unlock()
+
+	// the client has already been replaced, so retrying would only create
+	// another token.
+	if err := p.watchToken(client); err != nil {
+		return backoff.Permanent(err)
+	}
+
+	return nil
+}
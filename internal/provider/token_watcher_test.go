@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestProviderMeta_watchToken(t *testing.T) {
+	initialInterval := tokenReauthInitialInterval
+	tokenReauthInitialInterval = 10 * time.Millisecond
+	t.Cleanup(func() {
+		tokenReauthInitialInterval = initialInterval
+	})
+
+	var childTokens, createRequests, revokeRequests atomic.Int32
+
+	// the child tokens expire after 2s and cannot be renewed, so the provider
+	// must re-authenticate and create a new child token. The first attempt to
+	// re-authenticate fails and is retried. The replaced child token is left to
+	// expire, so that its leases are not revoked.
+	mockVaultHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var response map[string]interface{}
+		switch r.URL.Path {
+		case "/v1/auth/token/lookup-self":
+			response = map[string]interface{}{
+				"data": map[string]interface{}{
+					"id":        r.Header.Get("X-Vault-Token"),
+					"policies":  []string{"default"},
+					"ttl":       2,
+					"renewable": false,
+				},
+			}
+		case "/v1/auth/token/create":
+			if createRequests.Add(1) == 2 {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			response = map[string]interface{}{
+				"auth": map[string]interface{}{
+					"client_token":   fmt.Sprintf("child-token-%d", childTokens.Add(1)),
+					"policies":       []string{"default"},
+					"lease_duration": 2,
+					"renewable":      false,
+				},
+			}
+		case "/v1/auth/token/revoke-self":
+			revokeRequests.Add(1)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	})
+
+	config, ln := testutil.TestHTTPServer(t, mockVaultHandler)
+	defer ln.Close()
+
+	p := &ProviderMeta{
+		resourceData: schema.TestResourceDataRaw(t,
+			map[string]*schema.Schema{
+				consts.FieldAddress: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				consts.FieldToken: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				consts.FieldNamespace: {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+			map[string]interface{}{
+				consts.FieldAddress: config.Address,
+				consts.FieldToken:   "test-token",
+			},
+		),
+	}
+
+	client, err := p.GetClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if client.Token() != "child-token-1" {
+		t.Fatalf("expected token %q, actual %q", "child-token-1", client.Token())
+	}
+
+	if _, err := p.GetNSClient("ns1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.watchToken(client); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		got, err := p.GetClient()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != client {
+			if got.Token() != "child-token-2" {
+				t.Fatalf("expected token %q, actual %q", "child-token-2", got.Token())
+			}
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the client to be re-authenticated")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if got := revokeRequests.Load(); got != 0 {
+		t.Errorf("expected no token revoke requests, actual %d", got)
+	}
+
+	if got := createRequests.Load(); got != 3 {
+		t.Errorf("expected %d token create requests, actual %d", 3, got)
+	}
+
+	p.mu.Lock()
+	cached := len(p.clientCache)
+	p.mu.Unlock()
+	if cached != 0 {
+		t.Errorf("expected the namespaced client cache to be cleared, actual %d clients", cached)
+	}
+}
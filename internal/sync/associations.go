@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package syncutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// SyncAssociation is the sync status of a secret, or of one of its subkeys,
+// that is associated with a sync destination.
+type SyncAssociation struct {
+	Accessor   string `json:"accessor"`
+	SecretName string `json:"secret_name"`
+	SubKey     string `json:"sub_key"`
+	SyncStatus string `json:"sync_status"`
+	UpdatedAt  string `json:"updated_at"`
+	LastError  string `json:"last_error"`
+}
+
+// DecodeSyncAssociations decodes the associated secrets from the response data
+// of a destination's associations endpoint, sorted by mount accessor, secret
+// name and subkey.
+func DecodeSyncAssociations(data map[string]interface{}) ([]SyncAssociation, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("error converting vault response to JSON; err=%s", err)
+	}
+
+	var resp struct {
+		AssociatedSecrets map[string]SyncAssociation `json:"associated_secrets"`
+	}
+	if err := json.Unmarshal(b, &resp); err != nil {
+		return nil, fmt.Errorf("error converting JSON to sync associations; err=%s", err)
+	}
+
+	associations := make([]SyncAssociation, 0, len(resp.AssociatedSecrets))
+	for _, v := range resp.AssociatedSecrets {
+		associations = append(associations, v)
+	}
+	sort.Slice(associations, func(i, j int) bool {
+		a, b := associations[i], associations[j]
+		if a.Accessor != b.Accessor {
+			return a.Accessor < b.Accessor
+		}
+		if a.SecretName != b.SecretName {
+			return a.SecretName < b.SecretName
+		}
+		return a.SubKey < b.SubKey
+	})
+
+	return associations, nil
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package syncutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeSyncAssociations(t *testing.T) {
+	data := map[string]interface{}{
+		"associated_secrets": map[string]interface{}{
+			"kv_5678/b": map[string]interface{}{
+				"accessor":    "kv_5678",
+				"secret_name": "b",
+				"sync_status": "SYNCED",
+			},
+			"kv_1234/a/2": map[string]interface{}{
+				"accessor":    "kv_1234",
+				"secret_name": "a",
+				"sub_key":     "2",
+				"sync_status": "SYNCED",
+				"updated_at":  "2026-01-02T00:00:00Z",
+			},
+			"kv_1234/a/1": map[string]interface{}{
+				"accessor":    "kv_1234",
+				"secret_name": "a",
+				"sub_key":     "1",
+				"sync_status": "EXTERNAL_SERVICE_ERROR",
+				"updated_at":  "2026-01-01T00:00:00Z",
+				"last_error":  "rate limited",
+			},
+		},
+	}
+
+	associations, err := DecodeSyncAssociations(data)
+	require.NoError(t, err)
+	assert.Equal(t, []SyncAssociation{
+		{
+			Accessor:   "kv_1234",
+			SecretName: "a",
+			SubKey:     "1",
+			SyncStatus: "EXTERNAL_SERVICE_ERROR",
+			UpdatedAt:  "2026-01-01T00:00:00Z",
+			LastError:  "rate limited",
+		},
+		{
+			Accessor:   "kv_1234",
+			SecretName: "a",
+			SubKey:     "2",
+			SyncStatus: "SYNCED",
+			UpdatedAt:  "2026-01-02T00:00:00Z",
+		},
+		{
+			Accessor:   "kv_5678",
+			SecretName: "b",
+			SyncStatus: "SYNCED",
+		},
+	}, associations)
+
+	associations, err = DecodeSyncAssociations(map[string]interface{}{})
+	require.NoError(t, err)
+	assert.Empty(t, associations)
+}
@@ -129,6 +129,12 @@ func (r *CFAuthLoginEphemeralResource) Open(ctx context.Context, req ephemeral.O
 		return
 	}
 
+	vaultClient, err = data.WrappingClient(vaultClient)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
 	loginPath := fmt.Sprintf("auth/%s/%s", data.Mount.ValueString(), cfLoginPath)
 
 	requestData := map[string]any{
@@ -147,6 +153,11 @@ func (r *CFAuthLoginEphemeralResource) Open(ctx context.Context, req ephemeral.O
 		return
 	}
 
+	if data.SetWrapInfo(loginResp) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	if loginResp == nil || loginResp.Auth == nil {
 		resp.Diagnostics.AddError(
 			"Empty auth response from Vault",
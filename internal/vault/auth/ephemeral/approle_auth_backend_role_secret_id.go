@@ -131,6 +131,12 @@ func (r *ApproleAuthBackendRoleSecretIDEphemeralResource) Open(ctx context.Conte
 		return
 	}
 
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
 	backend := strings.Trim(data.Backend.ValueString(), "/")
 	role := strings.Trim(data.RoleName.ValueString(), "/")
 	path := fmt.Sprintf("auth/%s/role/%s/secret-id", backend, role)
@@ -174,6 +180,11 @@ func (r *ApproleAuthBackendRoleSecretIDEphemeralResource) Open(ctx context.Conte
 		return
 	}
 
+	if data.SetWrapInfo(secretResp) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	if secretResp == nil || secretResp.Data == nil {
 		resp.Diagnostics.AddError(
 			"Empty response from Vault",
@@ -8,8 +8,11 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/vault/api"
 
@@ -145,6 +148,9 @@ func (r *TokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaReq
 			consts.FieldWrappingTTL: schema.StringAttribute{
 				MarkdownDescription: "The TTL period of the wrapped token.",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot(consts.FieldWrapTTL)),
+				},
 			},
 			consts.FieldClientToken: schema.StringAttribute{
 				MarkdownDescription: "The client token value.",
@@ -308,6 +314,12 @@ func (r *TokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenReq
 		})
 
 		wrapped = true
+	} else {
+		c, err = data.WrappingClient(c)
+		if err != nil {
+			resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+			return
+		}
 	}
 
 	// Create the token
@@ -345,6 +357,13 @@ func (r *TokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenReq
 		return
 	}
 
+	// unlike wrapping_ttl, wrap_ttl only returns the wrap_info, the token is
+	// not revoked on close.
+	if !wrapped && data.SetWrapInfo(tokenResp) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	// Extract token information based on whether it's wrapped
 	var accessor string
 	var leaseID string
@@ -215,6 +215,12 @@ func (e *kerberosAuthBackendLoginEphemeral) Open(ctx context.Context, req epheme
 	// Set the Authorization header with the SPNEGO token on the cloned client
 	loginClient.AddHeader(spnego.HTTPHeaderAuthRequest, authHeaderVal)
 
+	loginClient, err = config.WrappingClient(loginClient)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
 	// Perform login with empty body (authentication is in the header)
 	// Use WriteWithContext to honor context cancellations and timeouts
 	tflog.Debug(ctx, fmt.Sprintf("Performing Kerberos login at '%s'", loginPath))
@@ -227,6 +233,11 @@ func (e *kerberosAuthBackendLoginEphemeral) Open(ctx context.Context, req epheme
 		return
 	}
 
+	if config.SetWrapInfo(secret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+		return
+	}
+
 	if secret == nil || secret.Auth == nil {
 		resp.Diagnostics.AddError(
 			"Kerberos login failed",
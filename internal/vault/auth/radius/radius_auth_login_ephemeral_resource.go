@@ -186,6 +186,12 @@ func (r *RadiusAuthLoginEphemeralResource) Open(ctx context.Context, req ephemer
 		return
 	}
 
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
 	mount := strings.Trim(data.Mount.ValueString(), "/")
 	username := strings.Trim(data.Username.ValueString(), "/")
 	path := fmt.Sprintf("auth/%s/login/%s", mount, username)
@@ -205,6 +211,11 @@ func (r *RadiusAuthLoginEphemeralResource) Open(ctx context.Context, req ephemer
 		return
 	}
 
+	if data.SetWrapInfo(loginResp) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	if loginResp == nil || loginResp.Auth == nil {
 		resp.Diagnostics.AddError(
 			"Empty response from Vault",
@@ -148,6 +148,12 @@ func (r *UserpassAuthLoginEphemeralResource) Open(ctx context.Context, req ephem
 		return
 	}
 
+	vaultClient, err = data.WrappingClient(vaultClient)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
 	loginPath := getUserpassLoginPath(data.Mount.ValueString(), data.Username.ValueString())
 	requestData := map[string]any{
 		consts.FieldPassword: data.Password.ValueString(),
@@ -162,6 +168,11 @@ func (r *UserpassAuthLoginEphemeralResource) Open(ctx context.Context, req ephem
 		return
 	}
 
+	if data.SetWrapInfo(loginResp) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	if loginResp == nil || loginResp.Auth == nil {
 		resp.Diagnostics.AddError(
 			"Empty auth response from Vault",
@@ -8,14 +8,18 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/lease"
 )
 
 // Ensure the implementation satisfies the ephemeral.EphemeralResource interface
@@ -29,7 +33,7 @@ var NewGenericEndpointEphemeralResource = func() ephemeral.EphemeralResource {
 
 // GenericEndpointEphemeralResource implements the methods that define this resource
 type GenericEndpointEphemeralResource struct {
-	base.EphemeralResourceWithConfigure
+	lease.EphemeralResourceWithLease
 }
 
 // GenericEndpointEphemeralModel describes the Terraform resource data model to match the
@@ -81,6 +85,9 @@ func (r *GenericEndpointEphemeralResource) Schema(_ context.Context, _ ephemeral
 			consts.FieldPathWrapTTL: schema.StringAttribute{
 				MarkdownDescription: "The TTL for the wrapped response.",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot(consts.FieldWrapTTL)),
+				},
 			},
 		},
 		MarkdownDescription: "Provides an ephemeral resource to write to a generic Vault endpoint and read response data.",
@@ -157,6 +164,12 @@ func (r *GenericEndpointEphemeralResource) Open(ctx context.Context, req ephemer
 		vc.SetWrappingLookupFunc(func(operation, path string) string {
 			return data.PathWrapTTL.ValueString()
 		})
+	} else {
+		vc, err = data.WrappingClient(vaultClient)
+		if err != nil {
+			resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+			return
+		}
 	}
 
 	// Write to Vault using WriteWithContext which properly handles POST for auth endpoints
@@ -169,6 +182,13 @@ func (r *GenericEndpointEphemeralResource) Open(ctx context.Context, req ephemer
 		return
 	}
 
+	// unlike path_wrap_ttl, wrap_ttl returns the wrap_info instead of the
+	// write_fields.
+	if data.IsWrapped() && data.SetWrapInfo(response) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	// Process write_fields if provided
 	writeDataMap := make(map[string]string)
 	writeData := make(map[string]interface{})
@@ -330,6 +350,8 @@ func (r *GenericEndpointEphemeralResource) Open(ctx context.Context, req ephemer
 	}
 	data.WriteData = mapValue
 
+	r.SetLease(ctx, resp, data.Namespace.ValueString(), response)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
 }
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+var _ list.ListResourceWithConfigure = &EntityListResource{}
+var _ list.ListResourceWithRawV5Schemas = &EntityListResource{}
+
+// NewEntityListResource returns the implementation for this list resource to
+// be used by the Terraform Plugin Framework provider.
+func NewEntityListResource() list.ListResource {
+	return &EntityListResource{}
+}
+
+// EntityListResource lists the identity entities of a namespace as
+// vault_identity_entity resources.
+type EntityListResource struct {
+	base.SDKv2ListResource
+}
+
+// Metadata defines the list resource name, it matches the listed resource.
+func (r *EntityListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_entity"
+}
+
+// ListResourceConfigSchema defines the list resource configuration schema.
+func (r *EntityListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the identity entities in Vault.",
+		Attributes:          map[string]schema.Attribute{},
+	}
+
+	base.MustAddBaseListSchema(&resp.Schema)
+}
+
+// List streams the identity entities of the namespace.
+func (r *EntityListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	listIdentityObjects(ctx, &r.SDKv2ListResource, "identity/entity/id", req, stream)
+}
+
+// listIdentityObjects streams the identity objects listed at path, they are
+// identified by their ID and displayed with their name.
+func listIdentityObjects(ctx context.Context, r *base.SDKv2ListResource, path string, req list.ListRequest, stream *list.ListResultsStream) {
+	var data base.BaseListModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		stream.Results = base.ListResultsError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	objects, err := readIdentityObjects(ctx, c, path)
+	if err != nil {
+		stream.Results = base.ListResultsError(errutil.VaultReadErr(err))
+		return
+	}
+
+	stream.Results = r.Results(ctx, req, data.Namespace.ValueString(), objects)
+}
+
+func readIdentityObjects(ctx context.Context, c *api.Client, path string) ([]base.ListObject, error) {
+	resp, err := c.Logical().ListWithContext(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil || resp.Data == nil {
+		return nil, nil
+	}
+
+	keyInfo, _ := resp.Data["key_info"].(map[string]interface{})
+	keys, _ := resp.Data["keys"].([]interface{})
+
+	objects := make([]base.ListObject, 0, len(keys))
+	for _, k := range keys {
+		id := k.(string)
+		name := id
+		if info, ok := keyInfo[id].(map[string]interface{}); ok {
+			if v, ok := info["name"].(string); ok && v != "" {
+				name = v
+			}
+		}
+
+		objects = append(objects, base.ListObject{
+			ID:          id,
+			DisplayName: name,
+		})
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].DisplayName < objects[j].DisplayName
+	})
+
+	return objects, nil
+}
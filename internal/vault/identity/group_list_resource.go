@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+)
+
+var _ list.ListResourceWithConfigure = &GroupListResource{}
+var _ list.ListResourceWithRawV5Schemas = &GroupListResource{}
+
+// NewGroupListResource returns the implementation for this list resource to
+// be used by the Terraform Plugin Framework provider.
+func NewGroupListResource() list.ListResource {
+	return &GroupListResource{}
+}
+
+// GroupListResource lists the identity groups of a namespace as
+// vault_identity_group resources.
+type GroupListResource struct {
+	base.SDKv2ListResource
+}
+
+// Metadata defines the list resource name, it matches the listed resource.
+func (r *GroupListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_group"
+}
+
+// ListResourceConfigSchema defines the list resource configuration schema.
+func (r *GroupListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the identity groups in Vault.",
+		Attributes:          map[string]schema.Attribute{},
+	}
+
+	base.MustAddBaseListSchema(&resp.Schema)
+}
+
+// List streams the identity groups of the namespace.
+func (r *GroupListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	listIdentityObjects(ctx, &r.SDKv2ListResource, "identity/group/id", req, stream)
+}
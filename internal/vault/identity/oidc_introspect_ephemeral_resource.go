@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+const oidcIntrospectPath = "/v1/identity/oidc/introspect"
+
+// Ensure the implementation satisfies the ephemeral.EphemeralResource interface
+var _ ephemeral.EphemeralResource = &OIDCIntrospectEphemeralResource{}
+
+// NewOIDCIntrospectEphemeralResource returns the implementation for this resource
+var NewOIDCIntrospectEphemeralResource = func() ephemeral.EphemeralResource {
+	return &OIDCIntrospectEphemeralResource{}
+}
+
+// OIDCIntrospectEphemeralResource implements the methods that define this resource
+type OIDCIntrospectEphemeralResource struct {
+	base.EphemeralResourceWithConfigure
+}
+
+// OIDCIntrospectModel describes the Terraform resource data model
+type OIDCIntrospectModel struct {
+	base.BaseModelEphemeral
+
+	Token    types.String `tfsdk:"token"`
+	ClientID types.String `tfsdk:"client_id"`
+	Active   types.Bool   `tfsdk:"active"`
+	Error    types.String `tfsdk:"error"`
+}
+
+// OIDCIntrospectAPIModel describes the Vault API data model
+type OIDCIntrospectAPIModel struct {
+	Active bool   `json:"active"`
+	Error  string `json:"error"`
+}
+
+func (r *OIDCIntrospectEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldToken: schema.StringAttribute{
+				MarkdownDescription: "The identity token to verify.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			consts.FieldClientID: schema.StringAttribute{
+				MarkdownDescription: "Client ID the token must have been issued for. If not set, the audience " +
+					"of the token is not checked.",
+				Optional: true,
+			},
+			consts.FieldActive: schema.BoolAttribute{
+				MarkdownDescription: "True if the token is valid, not expired and its entity is active.",
+				Computed:            true,
+			},
+			consts.FieldError: schema.StringAttribute{
+				MarkdownDescription: "The reason the token is not active, if any.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: "Verifies the signature, expiration and audience of an identity token " +
+			"issued by Vault, and that its entity is still active.",
+	}
+
+	base.MustAddBaseEphemeralSchema(&resp.Schema)
+}
+
+func (r *OIDCIntrospectEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_oidc_introspect"
+}
+
+func (r *OIDCIntrospectEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data OIDCIntrospectModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.IsWrapped() {
+		resp.Diagnostics.AddError(
+			"Invalid configuration",
+			fmt.Sprintf("%q is not supported, the introspection endpoint does not return a wrappable response", consts.FieldWrapTTL),
+		)
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	result, err := introspectOIDCToken(ctx, c, data.Token.ValueString(), data.ClientID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error introspecting identity token", err.Error())
+		return
+	}
+
+	data.Active = types.BoolValue(result.Active)
+	data.Error = types.StringValue(result.Error)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// introspectOIDCToken verifies an identity token. The endpoint returns the
+// result as the raw response body rather than as secret data, so the request
+// is not made with the logical client.
+func introspectOIDCToken(ctx context.Context, c *api.Client, token, clientID string) (*OIDCIntrospectAPIModel, error) {
+	body := map[string]interface{}{
+		consts.FieldToken: token,
+	}
+	if clientID != "" {
+		body[consts.FieldClientID] = clientID
+	}
+
+	r := c.NewRequest(http.MethodPost, oidcIntrospectPath)
+	if err := r.SetJSONBody(body); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.RawRequestWithContext(ctx, r)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result OIDCIntrospectAPIModel
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("error decoding introspection response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestIntrospectOIDCToken(t *testing.T) {
+	tests := []struct {
+		name     string
+		clientID string
+		wantBody map[string]string
+		want     *OIDCIntrospectAPIModel
+	}{
+		{
+			name:     "active",
+			wantBody: map[string]string{"token": "valid"},
+			want:     &OIDCIntrospectAPIModel{Active: true},
+		},
+		{
+			name:     "wrong-client-id",
+			clientID: "other",
+			wantBody: map[string]string{"token": "valid", "client_id": "other"},
+			want:     &OIDCIntrospectAPIModel{Active: false, Error: "token audience does not match other"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != oidcIntrospectPath {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+
+				var body map[string]string
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, tt.wantBody, body)
+
+				// The result is the raw response body, not wrapped in data.
+				resp := map[string]interface{}{"active": true}
+				if body["client_id"] != "" {
+					resp = map[string]interface{}{
+						"active": false,
+						"error":  "token audience does not match " + body["client_id"],
+					}
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(resp)
+			})
+
+			config, ln := testutil.TestHTTPServer(t, handler)
+			defer ln.Close()
+
+			c, err := api.NewClient(config)
+			require.NoError(t, err)
+
+			got, err := introspectOIDCToken(context.Background(), c, "valid", tt.clientID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
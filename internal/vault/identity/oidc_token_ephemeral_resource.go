@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
+)
+
+// Ensure the implementation satisfies the ephemeral.EphemeralResource interface
+var _ ephemeral.EphemeralResource = &OIDCTokenEphemeralResource{}
+
+// NewOIDCTokenEphemeralResource returns the implementation for this resource
+var NewOIDCTokenEphemeralResource = func() ephemeral.EphemeralResource {
+	return &OIDCTokenEphemeralResource{}
+}
+
+// OIDCTokenEphemeralResource implements the methods that define this resource
+type OIDCTokenEphemeralResource struct {
+	base.EphemeralResourceWithConfigure
+}
+
+// OIDCTokenModel describes the Terraform resource data model
+type OIDCTokenModel struct {
+	base.BaseModelEphemeral
+
+	Name     types.String `tfsdk:"name"`
+	Token    types.String `tfsdk:"token"`
+	ClientID types.String `tfsdk:"client_id"`
+	TTL      types.Int64  `tfsdk:"ttl"`
+}
+
+// OIDCTokenAPIModel describes the Vault API data model
+type OIDCTokenAPIModel struct {
+	Token    string `json:"token" mapstructure:"token"`
+	ClientID string `json:"client_id" mapstructure:"client_id"`
+	TTL      int64  `json:"ttl" mapstructure:"ttl"`
+}
+
+func (r *OIDCTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldName: schema.StringAttribute{
+				MarkdownDescription: "Name of the OIDC role to generate the token with.",
+				Required:            true,
+			},
+			consts.FieldToken: schema.StringAttribute{
+				MarkdownDescription: "The signed identity token (JWT).",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			consts.FieldClientID: schema.StringAttribute{
+				MarkdownDescription: "Client ID of the role, the audience of the token.",
+				Computed:            true,
+			},
+			consts.FieldTTL: schema.Int64Attribute{
+				MarkdownDescription: "TTL of the token in seconds.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: "Generates a signed identity token for the entity of the Vault token " +
+			"used by the provider, with an OIDC role of the identity secrets engine.",
+	}
+
+	base.MustAddBaseEphemeralSchema(&resp.Schema)
+}
+
+func (r *OIDCTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_oidc_token"
+}
+
+func (r *OIDCTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data OIDCTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	path := fmt.Sprintf("identity/oidc/token/%s", data.Name.ValueString())
+	secret, err := c.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error generating identity token",
+			fmt.Sprintf("Error generating identity token at %q: %s", path, err),
+		)
+		return
+	}
+
+	if data.SetWrapInfo(secret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
+	if secret == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+
+	var tokenResp OIDCTokenAPIModel
+	if err := model.ToAPIModel(secret.Data, &tokenResp); err != nil {
+		resp.Diagnostics.AddError("Unable to translate Vault response data", err.Error())
+		return
+	}
+
+	data.Token = types.StringValue(tokenResp.Token)
+	data.ClientID = types.StringValue(tokenResp.ClientID)
+	data.TTL = types.Int64Value(tokenResp.TTL)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package identity_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+// TestAccOIDCTokenEphemeralResource generates a token with a provider
+// authenticated with userpass, since the root token has no entity, and
+// introspects it with the default provider.
+func TestAccOIDCTokenEphemeralResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-test-oidc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOIDCTokenConfig(name),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey(consts.FieldToken), knownvalue.StringRegexp(regexp.MustCompile(`^ey[^.]+\.[^.]+\.[^.]+$`))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey(consts.FieldTTL), knownvalue.Int64Exact(3600)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey(consts.FieldActive), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey(consts.FieldError), knownvalue.StringExact("")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("wrong_audience"), knownvalue.Bool(false)),
+				},
+			},
+		},
+	})
+}
+
+func testAccOIDCTokenConfig(name string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "userpass" {
+  type = "userpass"
+  path = "%[1]s"
+}
+
+resource "vault_policy" "test" {
+  name   = "%[1]s"
+  policy = <<EOT
+path "identity/oidc/token/%[1]s" {
+  capabilities = ["read"]
+}
+EOT
+}
+
+resource "vault_userpass_auth_backend_user" "test" {
+  mount          = vault_auth_backend.userpass.path
+  username       = "user"
+  password_wo    = "password"
+  token_policies = ["default", vault_policy.test.name]
+}
+
+resource "vault_identity_oidc_key" "test" {
+  name               = "%[1]s"
+  allowed_client_ids = ["*"]
+}
+
+resource "vault_identity_oidc_role" "test" {
+  name = "%[1]s"
+  key  = vault_identity_oidc_key.test.name
+  ttl  = 3600
+}
+
+ephemeral "vault_userpass_auth_login" "test" {
+  mount    = vault_auth_backend.userpass.path
+  mount_id = vault_userpass_auth_backend_user.test.id
+  username = vault_userpass_auth_backend_user.test.username
+  password = "password"
+}
+
+provider "vault" {
+  alias            = "user"
+  address          = %[2]q
+  token            = ephemeral.vault_userpass_auth_login.test.client_token
+  skip_child_token = true
+}
+
+ephemeral "vault_identity_oidc_token" "test" {
+  provider = vault.user
+  mount_id = vault_identity_oidc_role.test.id
+  name     = vault_identity_oidc_role.test.name
+}
+
+ephemeral "vault_identity_oidc_introspect" "test" {
+  token     = ephemeral.vault_identity_oidc_token.test.token
+  client_id = ephemeral.vault_identity_oidc_token.test.client_id
+}
+
+ephemeral "vault_identity_oidc_introspect" "wrong_audience" {
+  token     = ephemeral.vault_identity_oidc_token.test.token
+  client_id = "wrong"
+}
+
+provider "echo" {
+  data = {
+    token          = ephemeral.vault_identity_oidc_token.test.token
+    ttl            = ephemeral.vault_identity_oidc_token.test.ttl
+    active         = ephemeral.vault_identity_oidc_introspect.test.active
+    error          = ephemeral.vault_identity_oidc_introspect.test.error
+    wrong_audience = ephemeral.vault_identity_oidc_introspect.wrong_audience.active
+  }
+}
+
+resource "echo" "test" {}
+`, name, os.Getenv("VAULT_ADDR"))
+}
@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package keymgmt
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+var _ action.ActionWithConfigure = &KeyRotateAction{}
+
+// KeyRotateAction rotates a Key Management key each time it is invoked,
+// unlike KeyRotateResource which only rotates the key on create.
+type KeyRotateAction struct {
+	base.ActionWithConfigure
+}
+
+type KeyRotateActionModel struct {
+	base.BaseActionModel
+	Mount types.String `tfsdk:"mount"`
+	Name  types.String `tfsdk:"name"`
+}
+
+func NewKeyRotateAction() action.Action {
+	return &KeyRotateAction{}
+}
+
+func (a *KeyRotateAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_keymgmt_key_rotate"
+}
+
+func (a *KeyRotateAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Rotates a Key Management key",
+
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Path of the Key Management secrets engine mount.",
+			},
+			consts.FieldName: schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Specifies the name of the key to rotate.",
+			},
+		},
+	}
+	base.MustAddBaseActionSchema(&resp.Schema)
+}
+
+func (a *KeyRotateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data KeyRotateActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cli, err := client.GetClient(ctx, a.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	base.SendProgress(resp, "Rotating Key Management key %q", data.Name.ValueString())
+
+	rotatePath := BuildKeyRotatePath(data.Mount.ValueString(), data.Name.ValueString())
+	if _, err := cli.Logical().WriteWithContext(ctx, rotatePath, map[string]interface{}{}); err != nil {
+		resp.Diagnostics.AddError(ErrCreating(ResourceTypeKeyRotation, rotatePath, err))
+		return
+	}
+
+	keyPath := BuildKeyPath(data.Mount.ValueString(), data.Name.ValueString())
+	vaultResp, err := cli.Logical().ReadWithContext(ctx, keyPath)
+	if err != nil {
+		resp.Diagnostics.AddError(ErrReading(ResourceTypeKey, keyPath, err))
+		return
+	}
+
+	if vaultResp != nil {
+		if v := SetInt64FromInterface(vaultResp.Data[consts.FieldLatestVersion]); !v.IsNull() {
+			base.SendProgress(resp, "Rotated Key Management key %q to version %d", data.Name.ValueString(), v.ValueInt64())
+			return
+		}
+	}
+
+	base.SendProgress(resp, "Rotated Key Management key %q", data.Name.ValueString())
+}
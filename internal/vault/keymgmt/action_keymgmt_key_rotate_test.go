@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package keymgmt_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccKeymgmtKeyRotateAction(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-keymgmt")
+	keyName := acctest.RandomWithPrefix("key")
+	resourceName := "vault_keymgmt_key.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		PreCheck:                 func() { acctestutil.TestEntPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testKeymgmtKeyRotateActionConfig(mount, keyName),
+			},
+			{
+				// refresh the key to read the version written by the action
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldLatestVersion, "2"),
+				),
+			},
+		},
+	})
+}
+
+func testKeymgmtKeyRotateActionConfig(mount, keyName string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "keymgmt" {
+  path = %q
+  type = "keymgmt"
+}
+
+resource "vault_keymgmt_key" "test" {
+  mount            = vault_mount.keymgmt.path
+  name             = %q
+  type             = "aes256-gcm96"
+  deletion_allowed = true
+}
+
+action "vault_keymgmt_key_rotate" "test" {
+  config {
+    mount = vault_mount.keymgmt.path
+    name  = vault_keymgmt_key.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = vault_keymgmt_key.test.name
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.vault_keymgmt_key_rotate.test]
+    }
+  }
+}
+`, mount, keyName)
+}
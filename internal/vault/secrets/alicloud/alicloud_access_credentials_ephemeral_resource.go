@@ -5,9 +5,7 @@ package alicloud
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
@@ -17,12 +15,12 @@ import (
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/lease"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
 )
 
 // Ensure the implementation satisfies the ephemeral.EphemeralResource interface
 var _ ephemeral.EphemeralResource = &AliCloudAccessCredentialsEphemeralResource{}
-var _ ephemeral.EphemeralResourceWithClose = &AliCloudAccessCredentialsEphemeralResource{}
 
 // NewAliCloudAccessCredentialsEphemeralResource returns the implementation for this resource to be
 // imported by the Terraform Plugin Framework provider
@@ -32,7 +30,7 @@ var NewAliCloudAccessCredentialsEphemeralResource = func() ephemeral.EphemeralRe
 
 // AliCloudAccessCredentialsEphemeralResource implements the methods that define this resource
 type AliCloudAccessCredentialsEphemeralResource struct {
-	base.EphemeralResourceWithConfigure
+	lease.EphemeralResourceWithLease
 }
 
 // AliCloudAccessCredentialsModel describes the Terraform resource data model to match the
@@ -139,6 +137,12 @@ func (r *AliCloudAccessCredentialsEphemeralResource) Open(ctx context.Context, r
 		return
 	}
 
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
 	mount = data.Mount.ValueString()
 	role := data.Role.ValueString()
 
@@ -151,6 +155,12 @@ func (r *AliCloudAccessCredentialsEphemeralResource) Open(ctx context.Context, r
 		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
 		return
 	}
+
+	if data.SetWrapInfo(sec) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	if sec == nil {
 		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
 		return
@@ -175,64 +185,7 @@ func (r *AliCloudAccessCredentialsEphemeralResource) Open(ctx context.Context, r
 	data.LeaseStartTime = types.StringValue(time.Now().Format(time.RFC3339))
 	data.LeaseRenewable = types.BoolValue(sec.Renewable)
 
-	// Store lease information in private data for cleanup in Close
-	if sec.LeaseID != "" {
-		privateData, err := json.Marshal(AliCloudAccessCredentialsPrivateData{
-			LeaseID:   sec.LeaseID,
-			Namespace: data.Namespace.ValueString(),
-		})
-		if err != nil {
-			log.Printf("[WARN] Failed to marshal private data: %s", err)
-		} else {
-			resp.Private.SetKey(ctx, "lease_data", privateData)
-		}
-	}
+	r.SetLease(ctx, resp, data.Namespace.ValueString(), sec)
 
 	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
 }
-
-// AliCloudAccessCredentialsPrivateData stores lease information for cleanup
-type AliCloudAccessCredentialsPrivateData struct {
-	LeaseID   string `json:"lease_id"`
-	Namespace string `json:"namespace"`
-}
-
-// Close revokes the credentials lease when the ephemeral resource is no longer needed
-func (r *AliCloudAccessCredentialsEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
-	privateBytes, diags := req.Private.GetKey(ctx, "lease_data")
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// If no private data, nothing to clean up
-	if len(privateBytes) == 0 {
-		return
-	}
-
-	var privateData AliCloudAccessCredentialsPrivateData
-	if err := json.Unmarshal(privateBytes, &privateData); err != nil {
-		log.Printf("[WARN] Failed to unmarshal private data: %s", err)
-		return
-	}
-
-	if privateData.LeaseID == "" {
-		// No lease to revoke
-		return
-	}
-
-	c, err := client.GetClient(ctx, r.Meta(), privateData.Namespace)
-	if err != nil {
-		resp.Diagnostics.AddError("Error configuring Vault client for revoke", err.Error())
-		return
-	}
-
-	// Attempt to revoke the lease
-	err = c.Sys().Revoke(privateData.LeaseID)
-	if err != nil {
-		// Log but do not fail resource close
-		log.Printf("[WARN] Failed to revoke lease %q: %s", privateData.LeaseID, err)
-	} else {
-		log.Printf("[DEBUG] Successfully revoked lease %q", privateData.LeaseID)
-	}
-}
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+var _ action.ActionWithConfigure = &AWSSecretBackendRotateRootAction{}
+
+// NewAWSSecretBackendRotateRootAction returns the implementation for this action
+var NewAWSSecretBackendRotateRootAction = func() action.Action {
+	return &AWSSecretBackendRotateRootAction{}
+}
+
+// AWSSecretBackendRotateRootAction rotates the root IAM credentials of an AWS
+// secrets engine.
+type AWSSecretBackendRotateRootAction struct {
+	base.ActionWithConfigure
+}
+
+// AWSSecretBackendRotateRootModel describes the Terraform action data model
+type AWSSecretBackendRotateRootModel struct {
+	base.BaseActionModel
+
+	Mount types.String `tfsdk:"mount"`
+}
+
+func (a *AWSSecretBackendRotateRootAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_aws_secret_backend_rotate_root"
+}
+
+func (a *AWSSecretBackendRotateRootAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Rotates the root IAM credentials of an AWS secrets engine. " +
+			"The new secret key is only known to Vault.",
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the AWS secrets engine is mounted.",
+				Required:            true,
+			},
+		},
+	}
+	base.MustAddBaseActionSchema(&resp.Schema)
+}
+
+func (a *AWSSecretBackendRotateRootAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data AWSSecretBackendRotateRootModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, a.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	path := fmt.Sprintf("%s/config/rotate-root", data.Mount.ValueString())
+	base.SendProgress(resp, "Rotating root credentials of AWS secrets engine %q", data.Mount.ValueString())
+
+	secret, err := c.Logical().WriteWithContext(ctx, path, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error rotating AWS root credentials",
+			fmt.Sprintf("Error rotating root credentials at path %q: %s", path, err),
+		)
+		return
+	}
+
+	if secret != nil {
+		if v, ok := secret.Data[consts.FieldAccessKey].(string); ok && v != "" {
+			base.SendProgress(resp, "Rotated root credentials of AWS secrets engine %q, new access key ID %q", data.Mount.ValueString(), v)
+			return
+		}
+	}
+
+	base.SendProgress(resp, "Rotated root credentials of AWS secrets engine %q", data.Mount.ValueString())
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package aws_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+// TestAccAWSSecretBackendRotateRootAction only covers the failure path,
+// rotating the root credentials would invalidate the test AWS credentials.
+func TestAccAWSSecretBackendRotateRootAction(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-aws")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "aws"
+}
+
+action "vault_aws_secret_backend_rotate_root" "test" {
+  config {
+    mount = vault_mount.test.path
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = vault_mount.test.path
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.vault_aws_secret_backend_rotate_root.test]
+    }
+  }
+}
+`, mount),
+				ExpectError: regexp.MustCompile(`Error rotating AWS root credentials`),
+			},
+		},
+	})
+}
@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccDatabaseSecretBackendRoleListResource(t *testing.T) {
+	connURL := testutil.SkipTestEnvUnset(t, "MYSQL_URL")[0]
+	mount := acctest.RandomWithPrefix("tf-test-database")
+	name := acctest.RandomWithPrefix("role")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend       = vault_mount.test.path
+  name          = "db"
+  allowed_roles = ["*"]
+
+  mysql {
+    connection_url = "%s"
+  }
+}
+
+resource "vault_database_secret_backend_role" "test" {
+  backend             = vault_mount.test.path
+  db_name             = vault_database_secret_backend_connection.test.name
+  name                = "%s"
+  creation_statements = ["SELECT 1;"]
+}
+`, mount, connURL, name),
+			},
+			{
+				Query: true,
+				Config: fmt.Sprintf(`
+list "vault_database_secret_backend_role" "test" {
+  provider = vault
+
+  config {
+    mount = "%s"
+  }
+}
+`, mount),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("vault_database_secret_backend_role.test", 1),
+					querycheck.ExpectIdentity("vault_database_secret_backend_role.test", map[string]knownvalue.Check{
+						consts.FieldID:        knownvalue.StringExact(mount + "/roles/" + name),
+						consts.FieldNamespace: knownvalue.Null(),
+					}),
+				},
+			},
+		},
+	})
+}
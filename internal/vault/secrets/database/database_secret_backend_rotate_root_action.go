@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+var _ action.ActionWithConfigure = &DatabaseSecretBackendRotateRootAction{}
+
+// NewDatabaseSecretBackendRotateRootAction returns the implementation for this action
+var NewDatabaseSecretBackendRotateRootAction = func() action.Action {
+	return &DatabaseSecretBackendRotateRootAction{}
+}
+
+// DatabaseSecretBackendRotateRootAction rotates the root credentials of a
+// database connection.
+type DatabaseSecretBackendRotateRootAction struct {
+	base.ActionWithConfigure
+}
+
+// DatabaseSecretBackendRotateRootModel describes the Terraform action data model
+type DatabaseSecretBackendRotateRootModel struct {
+	base.BaseActionModel
+
+	Mount types.String `tfsdk:"mount"`
+	Name  types.String `tfsdk:"name"`
+}
+
+func (a *DatabaseSecretBackendRotateRootAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_secret_backend_rotate_root"
+}
+
+func (a *DatabaseSecretBackendRotateRootAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Rotates the root credentials of a database connection. " +
+			"The new credentials are only known to Vault.",
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the Database secrets engine is mounted.",
+				Required:            true,
+			},
+			consts.FieldName: schema.StringAttribute{
+				MarkdownDescription: "Name of the database connection.",
+				Required:            true,
+			},
+		},
+	}
+	base.MustAddBaseActionSchema(&resp.Schema)
+}
+
+func (a *DatabaseSecretBackendRotateRootAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data DatabaseSecretBackendRotateRootModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, a.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	path := fmt.Sprintf("%s/rotate-root/%s", data.Mount.ValueString(), data.Name.ValueString())
+	base.SendProgress(resp, "Rotating root credentials of database connection %q", data.Name.ValueString())
+
+	if _, err := c.Logical().WriteWithContext(ctx, path, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error rotating database root credentials",
+			fmt.Sprintf("Error rotating root credentials at path %q: %s", path, err),
+		)
+		return
+	}
+
+	base.SendProgress(resp, "Rotated root credentials of database connection %q", data.Name.ValueString())
+}
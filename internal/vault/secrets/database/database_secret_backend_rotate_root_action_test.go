@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package database_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+// TestAccDatabaseSecretBackendRotateRootAction only covers the failure path,
+// rotating the root credentials of the shared test database would break the
+// other database tests.
+func TestAccDatabaseSecretBackendRotateRootAction(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-database")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "database"
+}
+
+action "vault_database_secret_backend_rotate_root" "test" {
+  config {
+    mount = vault_mount.test.path
+    name  = "missing"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = vault_mount.test.path
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.vault_database_secret_backend_rotate_root.test]
+    }
+  }
+}
+`, mount),
+				ExpectError: regexp.MustCompile(`Error rotating database root credentials`),
+			},
+		},
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/lease"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
 	"github.com/hashicorp/vault/api"
 )
@@ -27,7 +28,7 @@ var NewAWSAccessCredentialsEphemeralSecretResource = func() ephemeral.EphemeralR
 
 // AWSAccessCredentialsEphemeralSecretResource defines the method that defines this resource.
 type AWSAccessCredentialsEphemeralSecretResource struct {
-	base.EphemeralResourceWithConfigure
+	lease.EphemeralResourceWithLease
 }
 
 // AWSAccessCredentialsEphemeralSecretModel describes the terraform resource data model to match the
@@ -148,6 +149,12 @@ func (r *AWSAccessCredentialsEphemeralSecretResource) Open(ctx context.Context,
 		return
 	}
 
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
 	// Default type to "creds" if not specified
 	credType := "creds"
 	if !data.Type.IsNull() && !data.Type.IsUnknown() {
@@ -200,6 +207,12 @@ func (r *AWSAccessCredentialsEphemeralSecretResource) Open(ctx context.Context,
 		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
 		return
 	}
+
+	if data.SetWrapInfo(sec) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	if sec == nil {
 		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
 		return
@@ -224,5 +237,7 @@ func (r *AWSAccessCredentialsEphemeralSecretResource) Open(ctx context.Context,
 	data.LeaseStartTime = types.StringValue(time.Now().Format(time.RFC3339))
 	data.LeaseRenewable = types.BoolValue(sec.Renewable)
 
+	r.SetLease(ctx, resp, data.Namespace.ValueString(), sec)
+
 	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
 }
@@ -102,6 +102,12 @@ func (r *AWSStaticAccessCredentialsEphemeralSecretResource) Open(ctx context.Con
 		return
 	}
 
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
 	path := fmt.Sprintf("%s/static-creds/%s", data.Mount.ValueString(), data.Name.ValueString())
 
 	var secret *api.Secret
@@ -110,6 +116,12 @@ func (r *AWSStaticAccessCredentialsEphemeralSecretResource) Open(ctx context.Con
 		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
 		return
 	}
+
+	if data.SetWrapInfo(secret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	if secret == nil {
 		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
 		return
@@ -5,7 +5,6 @@ package ephemeralsecrets
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
@@ -26,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/lease"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
 	"github.com/hashicorp/vault/api"
 	"github.com/hashicorp/vault/sdk/helper/pointerutil"
@@ -51,7 +51,6 @@ var azureCloudConfigMap = map[string]cloud.Configuration{
 
 // Ensure the implementation satisfies the ephemeral.EphemeralResource interface
 var _ ephemeral.EphemeralResource = &AzureAccessCredentialsEphemeralResource{}
-var _ ephemeral.EphemeralResourceWithClose = &AzureAccessCredentialsEphemeralResource{}
 
 // NewAzureAccessCredentialsEphemeralResource returns the implementation for this resource to be
 // imported by the Terraform Plugin Framework provider
@@ -61,13 +60,7 @@ var NewAzureAccessCredentialsEphemeralResource = func() ephemeral.EphemeralResou
 
 // AzureAccessCredentialsEphemeralResource implements the methods that define this resource
 type AzureAccessCredentialsEphemeralResource struct {
-	base.EphemeralResourceWithConfigure
-}
-
-// AzureAccessCredentialsPrivateData stores data needed for cleanup in Close
-type AzureAccessCredentialsPrivateData struct {
-	LeaseID   string `json:"lease_id"`
-	Namespace string `json:"namespace"`
+	lease.EphemeralResourceWithLease
 }
 
 // AzureAccessCredentialsAPIModel describes the Vault API data model.
@@ -231,6 +224,13 @@ func (r *AzureAccessCredentialsEphemeralResource) Open(ctx context.Context, req
 	// Retry logic for reading credentials from Vault with exponential backoff
 	// Azure can return rate limit errors generating credentials when multiple
 	// requests are made during plan,apply,refresh in quick succession
+	// the config reads made to validate the credentials are not wrapped
+	wc, err := data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
 	var secret *api.Secret
 	bo := backoff.NewExponentialBackOff()
 	bo.InitialInterval = 2 * time.Second
@@ -241,9 +241,9 @@ func (r *AzureAccessCredentialsEphemeralResource) Open(ctx context.Context, req
 		func() error {
 			var readErr error
 			if readData != nil {
-				secret, readErr = c.Logical().ReadWithDataWithContext(ctx, credsPath, readData)
+				secret, readErr = wc.Logical().ReadWithDataWithContext(ctx, credsPath, readData)
 			} else {
-				secret, readErr = c.Logical().ReadWithContext(ctx, credsPath)
+				secret, readErr = wc.Logical().ReadWithContext(ctx, credsPath)
 			}
 			if readErr != nil {
 				if respErr, ok := readErr.(*api.ResponseError); ok {
@@ -273,6 +273,11 @@ func (r *AzureAccessCredentialsEphemeralResource) Open(ctx context.Context, req
 		return
 	}
 
+	if data.SetWrapInfo(secret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	if secret == nil {
 		resp.Diagnostics.AddError(
 			"No role found",
@@ -301,18 +306,7 @@ func (r *AzureAccessCredentialsEphemeralResource) Open(ctx context.Context, req
 	resp.Diagnostics.Append(md...)
 	data.Metadata = metaVal
 
-	// Store lease information in private data for cleanup in Close
-	if secret.LeaseID != "" {
-		privateData, err := json.Marshal(AzureAccessCredentialsPrivateData{
-			LeaseID:   secret.LeaseID,
-			Namespace: data.Namespace.ValueString(),
-		})
-		if err != nil {
-			log.Printf("[WARN] Failed to marshal private data: %s", err)
-		} else {
-			resp.Private.SetKey(ctx, "lease_data", privateData)
-		}
-	}
+	r.SetLease(ctx, resp, data.Namespace.ValueString(), secret)
 
 	// If we're not supposed to validate creds, we're done
 	if !data.ValidateCreds.ValueBool() {
@@ -484,46 +478,6 @@ func (r *AzureAccessCredentialsEphemeralResource) Open(ctx context.Context, req
 	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
 }
 
-// Close revokes the credentials lease when the ephemeral resource is no longer needed
-func (r *AzureAccessCredentialsEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
-	privateBytes, diags := req.Private.GetKey(ctx, "lease_data")
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// If no private data, nothing to clean up
-	if len(privateBytes) == 0 {
-		return
-	}
-
-	var privateData AzureAccessCredentialsPrivateData
-	if err := json.Unmarshal(privateBytes, &privateData); err != nil {
-		log.Printf("[WARN] Failed to unmarshal private data: %s", err)
-		return
-	}
-
-	if privateData.LeaseID == "" {
-		// No lease to revoke
-		return
-	}
-
-	c, err := client.GetClient(ctx, r.Meta(), privateData.Namespace)
-	if err != nil {
-		resp.Diagnostics.AddError("Error configuring Vault client for revoke", err.Error())
-		return
-	}
-
-	// Attempt to revoke the lease
-	err = c.Sys().Revoke(privateData.LeaseID)
-	if err != nil {
-		// Log but do not fail resource close
-		log.Printf("[WARN] Failed to revoke lease %q: %s", privateData.LeaseID, err)
-	} else {
-		log.Printf("[DEBUG] Successfully revoked lease %q", privateData.LeaseID)
-	}
-}
-
 func getAzureCloudConfigFromName(name string) (cloud.Configuration, error) {
 	if name == "" {
 		return cloud.AzurePublic, nil
@@ -114,6 +114,12 @@ func (r *AzureStaticCredsEphemeralSecretResource) Open(ctx context.Context, req
 		return
 	}
 
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
 	path := fmt.Sprintf("%s/static-creds/%s", data.Backend.ValueString(), data.Role.ValueString())
 
 	// readData holds query parameters for the Vault API request.
@@ -146,6 +152,12 @@ func (r *AzureStaticCredsEphemeralSecretResource) Open(ctx context.Context, req
 		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
 		return
 	}
+
+	if data.SetWrapInfo(sec) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	if sec == nil {
 		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
 		return
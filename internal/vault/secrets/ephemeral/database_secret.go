@@ -6,6 +6,7 @@ package ephemeralsecrets
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/lease"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
 )
 
@@ -28,7 +30,7 @@ var NewDBEphemeralSecretResource = func() ephemeral.EphemeralResource {
 
 // DBEphemeralSecretResource implements the methods that define this resource
 type DBEphemeralSecretResource struct {
-	base.EphemeralResourceWithConfigure
+	lease.EphemeralResourceWithLease
 }
 
 // DBEphemeralSecretModel describes the Terraform resource data model to match the
@@ -46,6 +48,10 @@ type DBEphemeralSecretModel struct {
 	ClientCertificate types.String `tfsdk:"client_certificate"`
 	PrivateKey        types.String `tfsdk:"private_key"`
 	PrivateKeyType    types.String `tfsdk:"private_key_type"`
+	LeaseID           types.String `tfsdk:"lease_id"`
+	LeaseDuration     types.Int64  `tfsdk:"lease_duration"`
+	LeaseStartTime    types.String `tfsdk:"lease_start_time"`
+	LeaseRenewable    types.Bool   `tfsdk:"lease_renewable"`
 }
 
 // DBEphemeralSecretAPIModel describes the Vault API data model.
@@ -99,6 +105,22 @@ func (r *DBEphemeralSecretResource) Schema(_ context.Context, _ ephemeral.Schema
 				MarkdownDescription: "Type of private key (e.g., 'rsa', 'ec'). Only returned when credential_type is 'client_certificate'.",
 				Computed:            true,
 			},
+			consts.FieldLeaseID: schema.StringAttribute{
+				MarkdownDescription: "Lease identifier assigned by vault.",
+				Computed:            true,
+			},
+			consts.FieldLeaseDuration: schema.Int64Attribute{
+				MarkdownDescription: "Lease duration in seconds relative to the time in lease_start_time.",
+				Computed:            true,
+			},
+			consts.FieldLeaseStartTime: schema.StringAttribute{
+				MarkdownDescription: "Time at which the lease was read, using the clock of the system where Terraform was running.",
+				Computed:            true,
+			},
+			consts.FieldLeaseRenewable: schema.BoolAttribute{
+				MarkdownDescription: "True if the duration of this lease can be extended through renewal.",
+				Computed:            true,
+			},
 		},
 		MarkdownDescription: "Provides an ephemeral resource to read a DB Secret from Vault.",
 	}
@@ -126,6 +148,12 @@ func (r *DBEphemeralSecretResource) Open(ctx context.Context, req ephemeral.Open
 		return
 	}
 
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
 	path := r.path(data.Mount.ValueString(), data.Name.ValueString())
 
 	secretResp, err := c.Logical().ReadWithContext(ctx, path)
@@ -136,6 +164,12 @@ func (r *DBEphemeralSecretResource) Open(ctx context.Context, req ephemeral.Open
 
 		return
 	}
+
+	if data.SetWrapInfo(secretResp) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	if secretResp == nil {
 		resp.Diagnostics.AddError(
 			errutil.VaultReadResponseNil(),
@@ -171,6 +205,13 @@ func (r *DBEphemeralSecretResource) Open(ctx context.Context, req ephemeral.Open
 		data.PrivateKeyType = types.StringValue(readResp.PrivateKeyType)
 	}
 
+	data.LeaseID = types.StringValue(secretResp.LeaseID)
+	data.LeaseDuration = types.Int64Value(int64(secretResp.LeaseDuration))
+	data.LeaseStartTime = types.StringValue(time.Now().Format(time.RFC3339))
+	data.LeaseRenewable = types.BoolValue(secretResp.Renewable)
+
+	r.SetLease(ctx, resp, data.Namespace.ValueString(), secretResp)
+
 	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
 }
 
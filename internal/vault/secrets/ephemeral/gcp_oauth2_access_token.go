@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/lease"
 )
 
 // isGCPTokenGenerationError checks if the error is a retryable GCP token generation error.
@@ -50,7 +51,7 @@ var NewGCPOAuth2AccessTokenEphemeralResource = func() ephemeral.EphemeralResourc
 
 // GCPOAuth2AccessTokenEphemeralResource implements the methods that define this resource
 type GCPOAuth2AccessTokenEphemeralResource struct {
-	base.EphemeralResourceWithConfigure
+	lease.EphemeralResourceWithLease
 }
 
 // GCPOAuth2AccessTokenModel describes the Terraform resource data model to match the
@@ -193,6 +194,12 @@ func (r *GCPOAuth2AccessTokenEphemeralResource) Open(ctx context.Context, req ep
 		return
 	}
 
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
 	mount := data.Mount.ValueString()
 	var tokenPath string
 	var resourceType string
@@ -276,6 +283,11 @@ func (r *GCPOAuth2AccessTokenEphemeralResource) Open(ctx context.Context, req ep
 		return
 	}
 
+	if data.SetWrapInfo(vaultSecret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	if vaultSecret == nil {
 		resp.Diagnostics.AddError(
 			"No credentials found",
@@ -314,5 +326,7 @@ func (r *GCPOAuth2AccessTokenEphemeralResource) Open(ctx context.Context, req ep
 	data.LeaseStartTime = types.StringValue(time.Now().Format(time.RFC3339))
 	data.LeaseRenewable = types.BoolValue(vaultSecret.Renewable)
 
+	r.SetLease(ctx, resp, data.Namespace.ValueString(), vaultSecret)
+
 	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
 }
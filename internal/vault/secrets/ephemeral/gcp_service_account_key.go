@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/lease"
 )
 
 // Ensure the implementation satisfies the ephemeral.EphemeralResource interface
@@ -32,7 +33,7 @@ var NewGCPServiceAccountKeyEphemeralResource = func() ephemeral.EphemeralResourc
 
 // GCPServiceAccountKeyEphemeralResource implements the methods that define this resource
 type GCPServiceAccountKeyEphemeralResource struct {
-	base.EphemeralResourceWithConfigure
+	lease.EphemeralResourceWithLease
 }
 
 // GCPServiceAccountKeyModel describes the Terraform resource data model to match the
@@ -168,6 +169,12 @@ func (r *GCPServiceAccountKeyEphemeralResource) Open(ctx context.Context, req ep
 		return
 	}
 
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
 	mount := data.Mount.ValueString()
 	var credsPath string
 
@@ -198,6 +205,11 @@ func (r *GCPServiceAccountKeyEphemeralResource) Open(ctx context.Context, req ep
 		return
 	}
 
+	if data.SetWrapInfo(vaultSecret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	if vaultSecret == nil {
 		resp.Diagnostics.AddError(
 			"No credentials found",
@@ -251,5 +263,7 @@ func (r *GCPServiceAccountKeyEphemeralResource) Open(ctx context.Context, req ep
 	data.LeaseStartTime = types.StringValue(time.Now().Format(time.RFC3339))
 	data.LeaseRenewable = types.BoolValue(vaultSecret.Renewable)
 
+	r.SetLease(ctx, resp, data.Namespace.ValueString(), vaultSecret)
+
 	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
 }
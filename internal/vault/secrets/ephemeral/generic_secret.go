@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/lease"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -30,7 +31,7 @@ var NewGenericEphemeralSecretResource = func() ephemeral.EphemeralResource {
 
 // GenericEphemeralSecretResource implements the methods that define this resource
 type GenericEphemeralSecretResource struct {
-	base.EphemeralResourceWithConfigure
+	lease.EphemeralResourceWithLease
 }
 
 // GenericEphemeralSecretModel describes the Terraform resource data model to match the
@@ -123,6 +124,12 @@ func (r *GenericEphemeralSecretResource) Open(ctx context.Context, req ephemeral
 		return
 	}
 
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
 	path := data.Path.ValueString()
 
 	// Read the secret, handling both versioned and non-versioned secrets
@@ -144,6 +151,11 @@ func (r *GenericEphemeralSecretResource) Open(ctx context.Context, req ephemeral
 		return
 	}
 
+	if data.SetWrapInfo(secretResp) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	if secretResp == nil {
 		resp.Diagnostics.AddError(
 			errutil.VaultReadResponseNil(),
@@ -204,5 +216,7 @@ func (r *GenericEphemeralSecretResource) Open(ctx context.Context, req ephemeral
 		data.LeaseStartTime = types.StringValue(time.Now().UTC().Format(time.RFC3339))
 	}
 
+	r.SetLease(ctx, resp, data.Namespace.ValueString(), secretResp)
+
 	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
 }
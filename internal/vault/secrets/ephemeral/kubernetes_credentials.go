@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/lease"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
 )
 
@@ -28,7 +29,7 @@ var NewKubernetesServiceAccountTokenEphemeralResource = func() ephemeral.Ephemer
 
 // KubernetesServiceAccountTokenEphemeralResource implements the methods that define this resource
 type KubernetesServiceAccountTokenEphemeralResource struct {
-	base.EphemeralResourceWithConfigure
+	lease.EphemeralResourceWithLease
 }
 
 // KubernetesServiceAccountTokenModel describes the Terraform resource data model to match the
@@ -137,6 +138,12 @@ func (r *KubernetesServiceAccountTokenEphemeralResource) Open(ctx context.Contex
 		return
 	}
 
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
 	// Prepare the request data
 	requestData := make(map[string]interface{})
 	requestData[consts.FieldKubernetesNamespace] = data.KubernetesNamespace.ValueString()
@@ -160,6 +167,11 @@ func (r *KubernetesServiceAccountTokenEphemeralResource) Open(ctx context.Contex
 		return
 	}
 
+	if data.SetWrapInfo(secretResp) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	if secretResp == nil {
 		resp.Diagnostics.AddError(
 			"Vault API returned no data",
@@ -182,6 +194,8 @@ func (r *KubernetesServiceAccountTokenEphemeralResource) Open(ctx context.Contex
 	data.LeaseDuration = types.Int64Value(int64(secretResp.LeaseDuration))
 	data.LeaseRenewable = types.BoolValue(secretResp.Renewable)
 
+	r.SetLease(ctx, resp, data.Namespace.ValueString(), secretResp)
+
 	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
 }
 
@@ -136,6 +136,12 @@ func (r *KVV2EphemeralSecretResource) Open(ctx context.Context, req ephemeral.Op
 		return
 	}
 
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
 	// read the name from the id field to support the import command
 	path := r.path(data.Mount.ValueString(), data.Name.ValueString())
 
@@ -158,6 +164,12 @@ func (r *KVV2EphemeralSecretResource) Open(ctx context.Context, req ephemeral.Op
 
 		return
 	}
+
+	if data.SetWrapInfo(secretResp) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	if secretResp == nil {
 		resp.Diagnostics.AddError(
 			errutil.VaultReadResponseNil(),
@@ -5,7 +5,6 @@ package ephemeralsecrets
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
@@ -15,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/lease"
 	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
 )
 
@@ -29,7 +29,7 @@ var NewTerraformTokenEphemeralSecretResource = func() ephemeral.EphemeralResourc
 
 // TerraformTokenEphemeralSecretResource implements the methods that define this resource
 type TerraformTokenEphemeralSecretResource struct {
-	base.EphemeralResourceWithConfigure
+	lease.EphemeralResourceWithLease
 }
 
 // TerraformTokenEphemeralSecretModel describes the Terraform resource data model to match the
@@ -49,11 +49,6 @@ type TerraformTokenEphemeralSecretAPIModel struct {
 	Token string `json:"token" mapstructure:"token"`
 }
 
-type PrivateData struct {
-	LeaseID   string `json:"lease_id"`
-	Namespace string `json:"namespace,omitempty"` // Optional, used for namespaced resources
-}
-
 // Schema defines this resource's schema which is the data that is available in
 // the resource's configuration, plan, and state
 //
@@ -107,6 +102,12 @@ func (r *TerraformTokenEphemeralSecretResource) Open(ctx context.Context, req ep
 		return
 	}
 
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
 	path := r.path(data.Mount.ValueString(), data.RoleName.ValueString())
 
 	secretResp, err := c.Logical().ReadWithContext(ctx, path)
@@ -117,6 +118,12 @@ func (r *TerraformTokenEphemeralSecretResource) Open(ctx context.Context, req ep
 
 		return
 	}
+
+	if data.SetWrapInfo(secretResp) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	if secretResp == nil {
 		resp.Diagnostics.AddError(
 			errutil.VaultReadResponseNil(),
@@ -133,10 +140,7 @@ func (r *TerraformTokenEphemeralSecretResource) Open(ctx context.Context, req ep
 	}
 
 	data.Token = types.StringValue(readResp.Token)
-	privateData, _ := json.Marshal(PrivateData{
-		LeaseID:   secretResp.LeaseID,
-		Namespace: data.Namespace.ValueString()})
-	resp.Private.SetKey(ctx, "private_data", privateData)
+	r.SetLease(ctx, resp, data.Namespace.ValueString(), secretResp)
 
 	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
 }
@@ -144,38 +148,3 @@ func (r *TerraformTokenEphemeralSecretResource) Open(ctx context.Context, req ep
 func (r *TerraformTokenEphemeralSecretResource) path(mount, roleName string) string {
 	return fmt.Sprintf("%s/creds/%s", mount, roleName)
 }
-
-func (e *TerraformTokenEphemeralSecretResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
-	privateBytes, diags := req.Private.GetKey(ctx, "private_data")
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	var privateData PrivateData
-	if err := json.Unmarshal(privateBytes, &privateData); err != nil {
-		resp.Diagnostics.AddError("Unable to unmarshal private data", err.Error())
-		return
-	}
-	c, err := client.GetClient(ctx, e.Meta(), privateData.Namespace)
-	if err != nil {
-		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
-		return
-	}
-	if privateData.LeaseID != "" {
-		// Revoke the token using the LeaseID
-		err = c.Sys().RevokeWithContext(ctx, privateData.LeaseID)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error revoking token",
-				err.Error(),
-			)
-			return
-		}
-	} else {
-		resp.Diagnostics.AddError(
-			"Token will not be revoked as no LeaseID was provided. This is a problem with the provider.",
-			"Token will not be revoked as no LeaseID was provided. This is a problem with the provider.",
-		)
-	}
-}
@@ -93,6 +93,12 @@ func (r *GCPKMSDecryptEphemeralResource) Open(ctx context.Context, req ephemeral
 		return
 	}
 
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
 	path := fmt.Sprintf("%s/decrypt/%s", data.Mount.ValueString(), data.KeyName.ValueString())
 
 	tflog.Debug(ctx, "Decrypting with GCP KMS", map[string]interface{}{
@@ -121,6 +127,11 @@ func (r *GCPKMSDecryptEphemeralResource) Open(ctx context.Context, req ephemeral
 		return
 	}
 
+	if data.SetWrapInfo(secret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	if secret == nil {
 		resp.Diagnostics.AddError(
 			"No response from decryption endpoint",
@@ -102,6 +102,12 @@ func (r *GCPKMSEncryptEphemeralResource) Open(ctx context.Context, req ephemeral
 		return
 	}
 
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
 	path := fmt.Sprintf("%s/encrypt/%s", data.Mount.ValueString(), data.KeyName.ValueString())
 
 	tflog.Debug(ctx, "Encrypting with GCP KMS", map[string]interface{}{
@@ -130,6 +136,11 @@ func (r *GCPKMSEncryptEphemeralResource) Open(ctx context.Context, req ephemeral
 		return
 	}
 
+	if data.SetWrapInfo(secret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	if secret == nil {
 		resp.Diagnostics.AddError(
 			"No response from encryption endpoint",
@@ -98,6 +98,12 @@ func (r *GCPKMSReencryptEphemeralResource) Open(ctx context.Context, req ephemer
 		return
 	}
 
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
 	path := fmt.Sprintf("%s/reencrypt/%s", data.Mount.ValueString(), data.KeyName.ValueString())
 
 	tflog.Debug(ctx, "Re-encrypting with GCP KMS", map[string]interface{}{
@@ -126,6 +132,11 @@ func (r *GCPKMSReencryptEphemeralResource) Open(ctx context.Context, req ephemer
 		return
 	}
 
+	if data.SetWrapInfo(secret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	if secret == nil {
 		resp.Diagnostics.AddError(
 			"No response from re-encryption endpoint",
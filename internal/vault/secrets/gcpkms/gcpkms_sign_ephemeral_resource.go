@@ -86,6 +86,12 @@ func (r *GCPKMSSignEphemeralResource) Open(ctx context.Context, req ephemeral.Op
 		return
 	}
 
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
 	path := fmt.Sprintf("%s/sign/%s", data.Mount.ValueString(), data.KeyName.ValueString())
 
 	tflog.Debug(ctx, "Signing with GCP KMS", map[string]interface{}{
@@ -110,6 +116,11 @@ func (r *GCPKMSSignEphemeralResource) Open(ctx context.Context, req ephemeral.Op
 		return
 	}
 
+	if data.SetWrapInfo(secret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	if secret == nil {
 		resp.Diagnostics.AddError(
 			"No response from signing endpoint",
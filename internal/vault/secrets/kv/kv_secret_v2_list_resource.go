@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kv
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+var _ list.ListResourceWithConfigure = &KVSecretV2ListResource{}
+var _ list.ListResourceWithRawV5Schemas = &KVSecretV2ListResource{}
+
+// NewKVSecretV2ListResource returns the implementation for this list resource
+// to be used by the Terraform Plugin Framework provider.
+func NewKVSecretV2ListResource() list.ListResource {
+	return &KVSecretV2ListResource{}
+}
+
+// KVSecretV2ListResource lists the secrets of a KV v2 mount as
+// vault_kv_secret_v2 resources.
+type KVSecretV2ListResource struct {
+	base.SDKv2ListResource
+}
+
+// Metadata defines the list resource name, it matches the listed resource.
+func (r *KVSecretV2ListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kv_secret_v2"
+}
+
+// ListResourceConfigSchema defines the list resource configuration schema.
+func (r *KVSecretV2ListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the secrets of a KV v2 secrets engine, including the secrets of all sub-paths.",
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Path where the KV v2 secrets engine is mounted.",
+			},
+		},
+	}
+
+	base.MustAddBaseListSchema(&resp.Schema)
+}
+
+// List streams the secrets of the mount.
+func (r *KVSecretV2ListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data base.MountListModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		stream.Results = base.ListResultsError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	mount := strings.Trim(data.Mount.ValueString(), "/")
+	names, err := listSecretsRecursive(ctx, c, mount, "")
+	if err != nil {
+		stream.Results = base.ListResultsError(errutil.VaultReadErr(err))
+		return
+	}
+
+	objects := make([]base.ListObject, 0, len(names))
+	for _, name := range names {
+		objects = append(objects, base.ListObject{
+			ID:          mount + "/data/" + name,
+			DisplayName: name,
+		})
+	}
+
+	stream.Results = r.Results(ctx, req, data.Namespace.ValueString(), objects)
+}
+
+// listSecretsRecursive returns the names of all secrets under prefix, keys
+// ending with a "/" are sub-paths and are listed in turn.
+func listSecretsRecursive(ctx context.Context, c *api.Client, mount, prefix string) ([]string, error) {
+	keys, err := base.ListKeys(ctx, c, mount+"/metadata/"+prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, k := range keys {
+		if !strings.HasSuffix(k, "/") {
+			names = append(names, prefix+k)
+			continue
+		}
+
+		sub, err := listSecretsRecursive(ctx, c, mount, prefix+k)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, sub...)
+	}
+
+	return names, nil
+}
@@ -0,0 +1,626 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kv
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+// defaultMaxConcurrency is the default number of secrets that are written or
+// deleted at the same time.
+const defaultMaxConcurrency = 10
+
+var (
+	_ resource.ResourceWithConfigure      = &KVSecretsV2Resource{}
+	_ resource.ResourceWithModifyPlan     = &KVSecretsV2Resource{}
+	_ resource.ResourceWithValidateConfig = &KVSecretsV2Resource{}
+)
+
+// NewKVSecretsV2Resource returns the implementation for this resource to be
+// imported by the Terraform Plugin Framework provider.
+func NewKVSecretsV2Resource() resource.Resource {
+	return &KVSecretsV2Resource{}
+}
+
+// KVSecretsV2Resource manages a set of secrets under a single KV v2 mount.
+type KVSecretsV2Resource struct {
+	base.ResourceWithConfigure
+}
+
+// KVSecretsV2Model describes the Terraform resource data model to match the
+// resource schema.
+type KVSecretsV2Model struct {
+	base.BaseModel
+
+	Mount             types.String `tfsdk:"mount"`
+	SecretsWO         types.Map    `tfsdk:"secrets_wo"`
+	SecretsWOVersion  types.Int64  `tfsdk:"secrets_wo_version"`
+	Paths             types.Set    `tfsdk:"paths"`
+	MaxConcurrency    types.Int64  `tfsdk:"max_concurrency"`
+	DeleteAllVersions types.Bool   `tfsdk:"delete_all_versions"`
+}
+
+// Metadata defines the resource name as it would appear in Terraform configurations
+func (r *KVSecretsV2Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kv_secrets_v2"
+}
+
+// Schema defines this resource's schema
+func (r *KVSecretsV2Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the KV v2 secrets engine is mounted.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldSecretsWO: schema.MapAttribute{
+				ElementType: types.StringType,
+				MarkdownDescription: "Map of secret paths, relative to the mount, to the JSON encoded data of each secret. " +
+					"This is a write-only field and will not be read back from Vault.",
+				Required:  true,
+				WriteOnly: true,
+				Sensitive: true,
+			},
+			consts.FieldSecretsWOVersion: schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: "Version counter for the write-only `secrets_wo` field. " +
+					"Since write-only values are not stored in state, Terraform cannot detect when the data of a secret changes. " +
+					"Increment this value whenever you update `secrets_wo` so Terraform rewrites all the secrets.",
+			},
+			consts.FieldPaths: schema.SetAttribute{
+				ElementType: types.StringType,
+				MarkdownDescription: "The paths of the secrets managed by this resource. " +
+					"Secrets that are removed outside of Terraform are dropped from this set and written again on the next apply.",
+				Computed: true,
+			},
+			consts.FieldMaxConcurrency: schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of secrets that are written, deleted or whose metadata is read at the same time. Defaults to `%d`.", defaultMaxConcurrency),
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultMaxConcurrency),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			consts.FieldDeleteAllVersions: schema.BoolAttribute{
+				MarkdownDescription: "If set to true, all versions and the metadata of the secrets are deleted " +
+					"when they are removed from `secrets_wo` or the resource is destroyed. " +
+					"Otherwise only the latest version of each secret is soft deleted.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+		MarkdownDescription: "Manages multiple secrets under a single KV v2 secrets engine mount.",
+	}
+
+	base.MustAddBaseSchema(&resp.Schema)
+}
+
+// ValidateConfig checks that the paths are unique and that each secret's data
+// is a JSON object.
+func (r *KVSecretsV2Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var secrets types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldSecretsWO), &secrets)...)
+	if resp.Diagnostics.HasError() || secrets.IsNull() || secrets.IsUnknown() {
+		return
+	}
+
+	seen := make(map[string]string)
+	for k, v := range secrets.Elements() {
+		attrPath := path.Root(consts.FieldSecretsWO).AtMapKey(k)
+
+		p := normalizeSecretPath(k)
+		if p == "" || strings.HasSuffix(k, "/") {
+			resp.Diagnostics.AddAttributeError(attrPath, "Invalid secret path",
+				fmt.Sprintf("%q is not a valid secret path", k))
+			continue
+		}
+		if other, ok := seen[p]; ok {
+			resp.Diagnostics.AddAttributeError(attrPath, "Duplicate secret path",
+				fmt.Sprintf("%q and %q refer to the same secret", other, k))
+			continue
+		}
+		seen[p] = k
+
+		s, ok := v.(types.String)
+		if !ok || s.IsNull() || s.IsUnknown() {
+			continue
+		}
+		if _, err := decodeSecretData(s.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(attrPath, "Invalid secret data", err.Error())
+		}
+	}
+}
+
+// ModifyPlan sets the planned paths from the keys of the write-only
+// secrets_wo field, which are only available in the configuration, and checks
+// the capabilities of the provider token on them, see the
+// plan_capability_check provider argument.
+func (r *KVSecretsV2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip on destroy (no plan)
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var secrets types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.FieldSecretsWO), &secrets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	paths := types.SetUnknown(types.StringType)
+	if !secrets.IsUnknown() {
+		var diags diag.Diagnostics
+		paths, diags = types.SetValueFrom(ctx, types.StringType, secretPaths(secrets))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(consts.FieldPaths), paths)...)
+	if resp.Diagnostics.HasError() || secrets.IsUnknown() {
+		return
+	}
+
+	var mount types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(consts.FieldMount), &mount)...)
+	if resp.Diagnostics.HasError() || mount.IsUnknown() {
+		return
+	}
+
+	var dataPaths []string
+	for _, p := range secretPaths(secrets) {
+		dataPaths = append(dataPaths, strings.Trim(mount.ValueString(), "/")+"/data/"+p)
+	}
+
+	r.CheckPlanCapabilities(ctx, req, resp, dataPaths)
+}
+
+// Create is called during the terraform apply command.
+func (r *KVSecretsV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data KVSecretsV2Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secrets, diags := configSecrets(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	mount := strings.Trim(data.Mount.ValueString(), "/")
+	if err := kvV2MountPreflight(ctx, c, mount); err != nil {
+		resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
+		return
+	}
+
+	written, err := writeSecrets(ctx, c, mount, secrets, sortedKeys(secrets), int(data.MaxConcurrency.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
+	}
+
+	resp.Diagnostics.Append(setPaths(ctx, &data, written)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called during the terraform apply, terraform plan, and terraform
+// refresh commands. Paths whose secret no longer exists, or whose current
+// version is deleted, are removed from state.
+func (r *KVSecretsV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data KVSecretsV2Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	var paths []string
+	resp.Diagnostics.Append(data.Paths.ElementsAs(ctx, &paths, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mount := strings.Trim(data.Mount.ValueString(), "/")
+	existing, err := existingSecrets(ctx, c, mount, paths, int(data.MaxConcurrency.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+		return
+	}
+
+	if len(existing) != len(paths) {
+		tflog.Warn(ctx, fmt.Sprintf("%d secrets under %q not found, removing them from state",
+			len(paths)-len(existing), mount))
+	}
+
+	resp.Diagnostics.Append(setPaths(ctx, &data, existing)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update writes the secrets that are new or all of them when
+// secrets_wo_version changes, and deletes the secrets that were removed from
+// the configuration.
+func (r *KVSecretsV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state KVSecretsV2Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secrets, diags := configSecrets(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var prior []string
+	resp.Diagnostics.Append(state.Paths.ElementsAs(ctx, &prior, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), plan.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	rewrite := !plan.SecretsWOVersion.Equal(state.SecretsWOVersion)
+	toWrite, toDelete := secretChanges(prior, secrets, rewrite)
+	limit := int(plan.MaxConcurrency.ValueInt64())
+	mount := strings.Trim(plan.Mount.ValueString(), "/")
+
+	result := make(map[string]bool, len(prior))
+	for _, p := range prior {
+		result[p] = true
+	}
+
+	if len(toWrite) > 0 {
+		if err := kvV2MountPreflight(ctx, c, mount); err != nil {
+			resp.Diagnostics.AddError(errutil.VaultUpdateErr(err))
+			return
+		}
+
+		written, err := writeSecrets(ctx, c, mount, secrets, toWrite, limit)
+		if err != nil {
+			resp.Diagnostics.AddError(errutil.VaultUpdateErr(err))
+		}
+		for _, p := range written {
+			result[p] = true
+		}
+	}
+
+	deleted, err := deleteSecrets(ctx, c, mount, toDelete, plan.DeleteAllVersions.ValueBool(), limit)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultUpdateErr(err))
+	}
+	for _, p := range deleted {
+		delete(result, p)
+	}
+
+	resp.Diagnostics.Append(setPaths(ctx, &plan, sortedKeys(result))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete is called during the terraform apply command.
+func (r *KVSecretsV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data KVSecretsV2Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var paths []string
+	resp.Diagnostics.Append(data.Paths.ElementsAs(ctx, &paths, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	mount := strings.Trim(data.Mount.ValueString(), "/")
+	if _, err := deleteSecrets(ctx, c, mount, paths, data.DeleteAllVersions.ValueBool(), int(data.MaxConcurrency.ValueInt64())); err != nil {
+		resp.Diagnostics.AddError(errutil.VaultDeleteErr(err))
+	}
+}
+
+// configSecrets returns the write-only secrets from the configuration keyed
+// by their normalized path.
+func configSecrets(ctx context.Context, config tfsdk.Config) (map[string]string, diag.Diagnostics) {
+	var raw map[string]string
+	diags := config.GetAttribute(ctx, path.Root(consts.FieldSecretsWO), &raw)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	secrets := make(map[string]string, len(raw))
+	for k, v := range raw {
+		secrets[normalizeSecretPath(k)] = v
+	}
+
+	return secrets, diags
+}
+
+// kvV2MountPreflight checks once that mount is a KV v2 secrets engine, rather
+// than once per secret.
+func kvV2MountPreflight(ctx context.Context, c *api.Client, mount string) error {
+	resp, err := c.Logical().ReadWithContext(ctx, "sys/internal/ui/mounts/"+mount)
+	if err != nil {
+		return fmt.Errorf("error reading the mount %q: %w", mount, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("mount %q not found", mount)
+	}
+
+	options, _ := resp.Data["options"].(map[string]interface{})
+	if v, _ := options[consts.FieldVersion].(string); v != "2" {
+		return fmt.Errorf("mount %q is not a KV v2 secrets engine", mount)
+	}
+
+	return nil
+}
+
+// writeSecrets writes the secrets at paths concurrently, it returns the paths
+// that were written.
+func writeSecrets(ctx context.Context, c *api.Client, mount string, secrets map[string]string, paths []string, limit int) ([]string, error) {
+	return forEachPath(paths, limit, func(p string) error {
+		data, err := decodeSecretData(secrets[p])
+		if err != nil {
+			return err
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Writing secret %q", p))
+		_, err = c.Logical().WriteWithContext(ctx, mount+"/data/"+p, map[string]interface{}{
+			consts.FieldData: data,
+		})
+		return err
+	})
+}
+
+// deleteSecrets deletes the secrets at paths concurrently, it returns the
+// paths that were deleted.
+func deleteSecrets(ctx context.Context, c *api.Client, mount string, paths []string, allVersions bool, limit int) ([]string, error) {
+	prefix := mount + "/data/"
+	if allVersions {
+		prefix = mount + "/metadata/"
+	}
+
+	return forEachPath(paths, limit, func(p string) error {
+		tflog.Debug(ctx, fmt.Sprintf("Deleting secret %q", p))
+		_, err := c.Logical().DeleteWithContext(ctx, prefix+p)
+		return err
+	})
+}
+
+// existingSecrets returns the paths whose secret exists and whose current
+// version is neither deleted nor destroyed, so that soft-deleted secrets are
+// written again. Each parent directory is listed once, and only the metadata
+// of the listed secrets is read, with at most limit reads at the same time.
+func existingSecrets(ctx context.Context, c *api.Client, mount string, paths []string, limit int) ([]string, error) {
+	dirs := make(map[string][]string)
+	for _, p := range paths {
+		i := strings.LastIndex(p, "/")
+		dirs[p[:i+1]] = append(dirs[p[:i+1]], p[i+1:])
+	}
+
+	var listed []string
+	for _, dir := range sortedKeys(dirs) {
+		keys, err := base.ListKeys(ctx, c, mount+"/metadata/"+dir)
+		if err != nil {
+			return nil, err
+		}
+
+		found := make(map[string]bool, len(keys))
+		for _, k := range keys {
+			found[k] = true
+		}
+		for _, name := range dirs[dir] {
+			if found[name] {
+				listed = append(listed, dir+name)
+			}
+		}
+	}
+
+	var mu sync.Mutex
+	deleted := make(map[string]bool)
+	if _, err := forEachPath(listed, limit, func(p string) error {
+		resp, err := c.Logical().ReadWithContext(ctx, mount+"/metadata/"+p)
+		if err != nil {
+			return err
+		}
+
+		if resp == nil || isCurrentVersionDeleted(resp.Data, time.Now()) {
+			tflog.Debug(ctx, fmt.Sprintf("The current version of secret %q is deleted", p))
+			mu.Lock()
+			deleted[p] = true
+			mu.Unlock()
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var existing []string
+	for _, p := range listed {
+		if !deleted[p] {
+			existing = append(existing, p)
+		}
+	}
+	sort.Strings(existing)
+
+	return existing, nil
+}
+
+// isCurrentVersionDeleted returns true if the current version in the metadata
+// of a secret is deleted or destroyed. The deletion time is in the future for
+// versions that are deleted automatically after delete_version_after.
+func isCurrentVersionDeleted(metadata map[string]interface{}, now time.Time) bool {
+	current, _ := metadata[consts.FieldCurrentVersion].(json.Number)
+	versions, _ := metadata[consts.FieldVersions].(map[string]interface{})
+	version, _ := versions[current.String()].(map[string]interface{})
+	if destroyed, _ := version[consts.FieldDestroyed].(bool); destroyed {
+		return true
+	}
+
+	v, _ := version[consts.FieldDeletionTime].(string)
+	if v == "" {
+		return false
+	}
+
+	deletionTime, err := time.Parse(time.RFC3339Nano, v)
+	return err != nil || !deletionTime.After(now)
+}
+
+// forEachPath calls fn for each path with at most limit calls running at the
+// same time. It returns the paths for which fn succeeded and the errors of the
+// others.
+func forEachPath(paths []string, limit int, fn func(string) error) ([]string, error) {
+	if limit < 1 {
+		limit = 1
+	}
+
+	errs := make([]error, len(paths))
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i, p := range paths {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := fn(p); err != nil {
+				errs[i] = fmt.Errorf("%q: %w", p, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	var done []string
+	for i, p := range paths {
+		if errs[i] == nil {
+			done = append(done, p)
+		}
+	}
+
+	return done, errors.Join(errs...)
+}
+
+// secretChanges returns the paths that must be written and deleted to go from
+// the prior paths to the configured secrets. All the secrets are written when
+// rewrite is true.
+func secretChanges(prior []string, secrets map[string]string, rewrite bool) (toWrite, toDelete []string) {
+	priorSet := make(map[string]bool, len(prior))
+	for _, p := range prior {
+		priorSet[p] = true
+		if _, ok := secrets[p]; !ok {
+			toDelete = append(toDelete, p)
+		}
+	}
+
+	for _, p := range sortedKeys(secrets) {
+		if rewrite || !priorSet[p] {
+			toWrite = append(toWrite, p)
+		}
+	}
+	sort.Strings(toDelete)
+
+	return toWrite, toDelete
+}
+
+func setPaths(ctx context.Context, data *KVSecretsV2Model, paths []string) diag.Diagnostics {
+	if paths == nil {
+		paths = []string{}
+	}
+
+	var diags diag.Diagnostics
+	data.Paths, diags = types.SetValueFrom(ctx, types.StringType, paths)
+	return diags
+}
+
+func secretPaths(secrets types.Map) []string {
+	paths := make([]string, 0, len(secrets.Elements()))
+	for k := range secrets.Elements() {
+		paths = append(paths, normalizeSecretPath(k))
+	}
+	sort.Strings(paths)
+
+	return paths
+}
+
+func normalizeSecretPath(p string) string {
+	return strings.Trim(p, "/")
+}
+
+func decodeSecretData(v string) (map[string]interface{}, error) {
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(v), &data); err != nil {
+		return nil, fmt.Errorf("secret data must be a JSON object: %w", err)
+	}
+	if data == nil {
+		return nil, errors.New("secret data must be a JSON object")
+	}
+
+	return data, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,192 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kv
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestSecretChanges(t *testing.T) {
+	secrets := map[string]string{
+		"app/db":  `{"password":"p"}`,
+		"app/new": `{"token":"t"}`,
+	}
+	prior := []string{"app/old", "app/db"}
+
+	toWrite, toDelete := secretChanges(prior, secrets, false)
+	assert.Equal(t, []string{"app/new"}, toWrite)
+	assert.Equal(t, []string{"app/old"}, toDelete)
+
+	toWrite, toDelete = secretChanges(prior, secrets, true)
+	assert.Equal(t, []string{"app/db", "app/new"}, toWrite)
+	assert.Equal(t, []string{"app/old"}, toDelete)
+}
+
+func TestForEachPath(t *testing.T) {
+	var running, maxRunning atomic.Int32
+	var m sync.Mutex
+	var called []string
+
+	done, err := forEachPath([]string{"a", "b", "c", "d", "e"}, 2, func(p string) error {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			cur := maxRunning.Load()
+			if n <= cur || maxRunning.CompareAndSwap(cur, n) {
+				break
+			}
+		}
+
+		m.Lock()
+		called = append(called, p)
+		m.Unlock()
+
+		if p == "c" {
+			return errors.New("permission denied")
+		}
+		return nil
+	})
+
+	assert.ElementsMatch(t, []string{"a", "b", "c", "d", "e"}, called)
+	assert.Equal(t, []string{"a", "b", "d", "e"}, done)
+	assert.EqualError(t, err, `"c": permission denied`)
+	assert.LessOrEqual(t, maxRunning.Load(), int32(2))
+}
+
+func TestDecodeSecretData(t *testing.T) {
+	data, err := decodeSecretData(`{"foo":"bar"}`)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"foo": "bar"}, data)
+
+	for _, v := range []string{`null`, `["foo"]`, `foo`} {
+		_, err := decodeSecretData(v)
+		assert.Error(t, err, v)
+	}
+}
+
+func TestExistingSecrets(t *testing.T) {
+	var lists, reads atomic.Int32
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data map[string]interface{}
+		if r.URL.Query().Get("list") == "true" {
+			lists.Add(1)
+
+			var keys []string
+			switch r.URL.Path {
+			case "/v1/kv/metadata":
+				keys = []string{"top", "app/"}
+			case "/v1/kv/metadata/app":
+				keys = []string{"db", "old", "nested/"}
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			data = map[string]interface{}{
+				"keys": keys,
+			}
+		} else {
+			reads.Add(1)
+
+			// top is active, the current version of app/db is deleted and
+			// the one of app/old is destroyed.
+			version := map[string]interface{}{
+				"deletion_time": "",
+				"destroyed":     false,
+			}
+			switch r.URL.Path {
+			case "/v1/kv/metadata/top":
+			case "/v1/kv/metadata/app/db":
+				version["deletion_time"] = "2026-01-01T00:00:00Z"
+			case "/v1/kv/metadata/app/old":
+				version["destroyed"] = true
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			data = map[string]interface{}{
+				"current_version": 2,
+				"versions": map[string]interface{}{
+					"1": map[string]interface{}{
+						"deletion_time": "",
+						"destroyed":     false,
+					},
+					"2": version,
+				},
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": data,
+		})
+	})
+
+	config, ln := testutil.TestHTTPServer(t, handler)
+	defer ln.Close()
+
+	c, err := api.NewClient(config)
+	require.NoError(t, err)
+
+	existing, err := existingSecrets(context.Background(), c, "kv",
+		[]string{"top", "app/db", "app/old", "app/api", "missing/secret"}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"top"}, existing)
+	assert.Equal(t, int32(3), lists.Load(), "expected one list per directory")
+	assert.Equal(t, int32(3), reads.Load(), "expected one read per listed secret")
+}
+
+func TestIsCurrentVersionDeleted(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		version map[string]interface{}
+		want    bool
+	}{
+		{
+			name:    "active",
+			version: map[string]interface{}{"deletion_time": "", "destroyed": false},
+			want:    false,
+		},
+		{
+			name:    "deleted",
+			version: map[string]interface{}{"deletion_time": "2026-01-01T00:00:00Z", "destroyed": false},
+			want:    true,
+		},
+		{
+			name:    "delete-version-after",
+			version: map[string]interface{}{"deletion_time": "2026-12-01T00:00:00Z", "destroyed": false},
+			want:    false,
+		},
+		{
+			name:    "destroyed",
+			version: map[string]interface{}{"deletion_time": "", "destroyed": true},
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metadata := map[string]interface{}{
+				"current_version": json.Number("3"),
+				"versions": map[string]interface{}{
+					"3": tt.version,
+				},
+			}
+			assert.Equal(t, tt.want, isCurrentVersionDeleted(metadata, now))
+		})
+	}
+}
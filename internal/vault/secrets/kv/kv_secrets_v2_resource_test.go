@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kv_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccKVSecretsV2(t *testing.T) {
+	resourceName := "vault_kv_secrets_v2.test"
+	mount := acctest.RandomWithPrefix("tf-test-kvv2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testKVSecretsV2Config(mount, 1, `
+    "app/db"  = jsonencode({ password = "p1" })
+    "app/api" = jsonencode({ token = "t1" })
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMount, mount),
+					resource.TestCheckResourceAttr(resourceName, "paths.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "paths.*", "app/db"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "paths.*", "app/api"),
+					resource.TestCheckNoResourceAttr(resourceName, consts.FieldSecretsWO),
+					testKVSecretsV2Data(mount, "app/db", "password", "p1"),
+					testKVSecretsV2Data(mount, "app/api", "token", "t1"),
+				),
+			},
+			{
+				// remove a secret and rewrite the others
+				Config: testKVSecretsV2Config(mount, 2, `
+    "app/db" = jsonencode({ password = "p2" })
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "paths.#", "1"),
+					testKVSecretsV2Data(mount, "app/db", "password", "p2"),
+					testKVSecretsV2Deleted(mount, "app/api"),
+				),
+			},
+			{
+				// the secret is written again after being removed outside of Terraform
+				PreConfig: func() {
+					client, err := api.NewClient(api.DefaultConfig())
+					if err != nil {
+						t.Fatalf("failed to create client: %v", err)
+					}
+					if _, err := client.Logical().Delete(mount + "/metadata/app/db"); err != nil {
+						t.Fatalf("failed to delete secret: %v", err)
+					}
+				},
+				Config: testKVSecretsV2Config(mount, 2, `
+    "app/db" = jsonencode({ password = "p2" })
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "paths.#", "1"),
+					testKVSecretsV2Data(mount, "app/db", "password", "p2"),
+				),
+			},
+		},
+	})
+}
+
+func testKVSecretsV2Data(mount, name, key, want string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client, err := api.NewClient(api.DefaultConfig())
+		if err != nil {
+			return err
+		}
+
+		secret, err := client.KVv2(mount).Get(context.Background(), name)
+		if err != nil {
+			return err
+		}
+		if got := secret.Data[key]; got != want {
+			return fmt.Errorf("expected %q of %q to be %q, got %v", key, name, want, got)
+		}
+
+		return nil
+	}
+}
+
+func testKVSecretsV2Deleted(mount, name string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client, err := api.NewClient(api.DefaultConfig())
+		if err != nil {
+			return err
+		}
+
+		if secret, err := client.KVv2(mount).Get(context.Background(), name); err == nil && secret.Data != nil {
+			return fmt.Errorf("expected %q to be deleted", name)
+		}
+
+		return nil
+	}
+}
+
+func testKVSecretsV2Config(mount string, version int, secrets string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "kv"
+  options = {
+    version = "2"
+  }
+}
+
+resource "vault_kv_secrets_v2" "test" {
+  mount              = vault_mount.test.path
+  secrets_wo_version = %d
+  secrets_wo = {
+%s
+  }
+}
+`, mount, version, secrets)
+}
@@ -0,0 +1,426 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pki
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	pkiutil "github.com/hashicorp/terraform-provider-vault/internal/pki"
+)
+
+const pkiCertPrivateDataKey = "private_data"
+
+// Ensure the implementation satisfies the ephemeral interfaces
+var (
+	_ ephemeral.EphemeralResource          = &PKISecretBackendCertEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose = &PKISecretBackendCertEphemeralResource{}
+)
+
+// NewPKISecretBackendCertEphemeralResource returns the implementation for this resource
+var NewPKISecretBackendCertEphemeralResource = func() ephemeral.EphemeralResource {
+	return &PKISecretBackendCertEphemeralResource{}
+}
+
+// PKISecretBackendCertEphemeralResource implements the ephemeral resource
+type PKISecretBackendCertEphemeralResource struct {
+	base.EphemeralResourceWithConfigure
+}
+
+// PKISecretBackendCertModel describes the Terraform resource data model
+type PKISecretBackendCertModel struct {
+	base.BaseModelEphemeral
+
+	Mount                types.String `tfsdk:"mount"`
+	Name                 types.String `tfsdk:"name"`
+	CommonName           types.String `tfsdk:"common_name"`
+	CSR                  types.String `tfsdk:"csr"`
+	AltNames             types.List   `tfsdk:"alt_names"`
+	IPSans               types.List   `tfsdk:"ip_sans"`
+	URISans              types.List   `tfsdk:"uri_sans"`
+	OtherSans            types.List   `tfsdk:"other_sans"`
+	UserIds              types.List   `tfsdk:"user_ids"`
+	TTL                  types.String `tfsdk:"ttl"`
+	NotAfter             types.String `tfsdk:"not_after"`
+	Format               types.String `tfsdk:"format"`
+	PrivateKeyFormat     types.String `tfsdk:"private_key_format"`
+	ExcludeCNFromSans    types.Bool   `tfsdk:"exclude_cn_from_sans"`
+	RemoveRootsFromChain types.Bool   `tfsdk:"remove_roots_from_chain"`
+	IssuerRef            types.String `tfsdk:"issuer_ref"`
+	CertMetadata         types.String `tfsdk:"cert_metadata"`
+	RevokeOnClose        types.Bool   `tfsdk:"revoke_on_close"`
+	RevokeWithKey        types.Bool   `tfsdk:"revoke_with_key"`
+
+	// Computed
+	Certificate    types.String `tfsdk:"certificate"`
+	IssuingCA      types.String `tfsdk:"issuing_ca"`
+	CAChain        types.String `tfsdk:"ca_chain"`
+	PrivateKey     types.String `tfsdk:"private_key"`
+	PrivateKeyType types.String `tfsdk:"private_key_type"`
+	SerialNumber   types.String `tfsdk:"serial_number"`
+	Expiration     types.Int64  `tfsdk:"expiration"`
+}
+
+// pkiCertPrivateData is what Close needs to revoke the certificate.
+type pkiCertPrivateData struct {
+	Namespace    string `json:"namespace,omitempty"`
+	Mount        string `json:"mount"`
+	SerialNumber string `json:"serial_number"`
+	PrivateKey   string `json:"private_key,omitempty"`
+}
+
+func (r *PKISecretBackendCertEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the PKI secrets engine is mounted.",
+				Required:            true,
+			},
+			consts.FieldName: schema.StringAttribute{
+				MarkdownDescription: "Name of the role to issue or sign the certificate against.",
+				Required:            true,
+			},
+			consts.FieldCommonName: schema.StringAttribute{
+				MarkdownDescription: "CN of the certificate. Required unless `csr` is set and the role uses the CSR common name.",
+				Optional:            true,
+			},
+			consts.FieldCSR: schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded CSR to sign. If set, the certificate is signed with the `sign` " +
+					"endpoint and no private key is returned, otherwise a key pair is generated with the `issue` endpoint.",
+				Optional: true,
+			},
+			consts.FieldAltNames: schema.ListAttribute{
+				MarkdownDescription: "List of alternative names.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			consts.FieldIPSans: schema.ListAttribute{
+				MarkdownDescription: "List of alternative IPs.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			consts.FieldURISans: schema.ListAttribute{
+				MarkdownDescription: "List of alternative URIs.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			consts.FieldOtherSans: schema.ListAttribute{
+				MarkdownDescription: "List of other SANs.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			consts.FieldUserIds: schema.ListAttribute{
+				MarkdownDescription: "List of Subject User IDs.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			consts.FieldTTL: schema.StringAttribute{
+				MarkdownDescription: "Time to live of the certificate.",
+				Optional:            true,
+			},
+			consts.FieldNotAfter: schema.StringAttribute{
+				MarkdownDescription: "Set the Not After field of the certificate with specified date value. " +
+					"The value format should be given in UTC format YYYY-MM-ddTHH:MM:SSZ.",
+				Optional: true,
+			},
+			consts.FieldFormat: schema.StringAttribute{
+				MarkdownDescription: "The format of data, one of `pem`, `der` or `pem_bundle`. Defaults to `pem`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("pem", "der", "pem_bundle"),
+				},
+			},
+			consts.FieldPrivateKeyFormat: schema.StringAttribute{
+				MarkdownDescription: "The private key format, one of `der` or `pkcs8`. Defaults to `der`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("der", "pkcs8"),
+					stringvalidator.ConflictsWith(path.MatchRoot(consts.FieldCSR)),
+				},
+			},
+			consts.FieldExcludeCNFromSans: schema.BoolAttribute{
+				MarkdownDescription: "Flag to exclude CN from SANs.",
+				Optional:            true,
+			},
+			consts.FieldRemoveRootsFromChain: schema.BoolAttribute{
+				MarkdownDescription: "If true, the returned `ca_chain` will not include any self-signed CA certificates.",
+				Optional:            true,
+			},
+			consts.FieldIssuerRef: schema.StringAttribute{
+				MarkdownDescription: "Specifies the issuer of this request, defaults to the role's issuer.",
+				Optional:            true,
+			},
+			consts.FieldCertMetadata: schema.StringAttribute{
+				MarkdownDescription: "A base 64 encoded value to associate with the certificate's serial number.",
+				Optional:            true,
+			},
+			consts.FieldRevokeOnClose: schema.BoolAttribute{
+				MarkdownDescription: "Revoke the certificate when Terraform closes the ephemeral resource, " +
+					"at the end of the run.",
+				Optional: true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot(consts.FieldWrapTTL)),
+				},
+			},
+			consts.FieldRevokeWithKey: schema.BoolAttribute{
+				MarkdownDescription: "Revoke the certificate with the `revoke-with-key` endpoint, which " +
+					"proves possession of the private key instead of requiring access to the `revoke` endpoint.",
+				Optional: true,
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot(consts.FieldRevokeOnClose)),
+					boolvalidator.ConflictsWith(path.MatchRoot(consts.FieldCSR)),
+				},
+			},
+			consts.FieldCertificate: schema.StringAttribute{
+				MarkdownDescription: "The certificate.",
+				Computed:            true,
+			},
+			consts.FieldIssuingCA: schema.StringAttribute{
+				MarkdownDescription: "The issuing CA.",
+				Computed:            true,
+			},
+			consts.FieldCAChain: schema.StringAttribute{
+				MarkdownDescription: "The CA chain.",
+				Computed:            true,
+			},
+			consts.FieldPrivateKey: schema.StringAttribute{
+				MarkdownDescription: "The private key, not set when signing a `csr`.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			consts.FieldPrivateKeyType: schema.StringAttribute{
+				MarkdownDescription: "The private key type.",
+				Computed:            true,
+			},
+			consts.FieldSerialNumber: schema.StringAttribute{
+				MarkdownDescription: "The serial number.",
+				Computed:            true,
+			},
+			consts.FieldExpiration: schema.Int64Attribute{
+				MarkdownDescription: "The certificate expiration as a Unix-style timestamp.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: "Issues a certificate, or signs a CSR, with the PKI secrets engine on each run, " +
+			"without storing the private key in state.",
+	}
+	base.MustAddBaseEphemeralSchema(&resp.Schema)
+}
+
+func (r *PKISecretBackendCertEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pki_secret_backend_cert"
+}
+
+func (r *PKISecretBackendCertEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data PKISecretBackendCertModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	mount := strings.Trim(data.Mount.ValueString(), "/")
+	name := strings.Trim(data.Name.ValueString(), "/")
+
+	requestData := map[string]interface{}{}
+	path := fmt.Sprintf("%s/issue/%s", mount, name)
+	if !data.CSR.IsNull() {
+		path = fmt.Sprintf("%s/sign/%s", mount, name)
+		requestData[consts.FieldCSR] = data.CSR.ValueString()
+	}
+
+	stringFields := map[string]types.String{
+		consts.FieldCommonName:       data.CommonName,
+		consts.FieldTTL:              data.TTL,
+		consts.FieldNotAfter:         data.NotAfter,
+		consts.FieldFormat:           data.Format,
+		consts.FieldPrivateKeyFormat: data.PrivateKeyFormat,
+		consts.FieldIssuerRef:        data.IssuerRef,
+		consts.FieldCertMetadata:     data.CertMetadata,
+	}
+	for k, v := range stringFields {
+		if !v.IsNull() {
+			requestData[k] = v.ValueString()
+		}
+	}
+
+	boolFields := map[string]types.Bool{
+		consts.FieldExcludeCNFromSans:    data.ExcludeCNFromSans,
+		consts.FieldRemoveRootsFromChain: data.RemoveRootsFromChain,
+	}
+	for k, v := range boolFields {
+		if !v.IsNull() {
+			requestData[k] = v.ValueBool()
+		}
+	}
+
+	// the API expects comma separated strings
+	listFields := map[string]types.List{
+		consts.FieldAltNames:  data.AltNames,
+		consts.FieldIPSans:    data.IPSans,
+		consts.FieldURISans:   data.URISans,
+		consts.FieldOtherSans: data.OtherSans,
+		consts.FieldUserIds:   data.UserIds,
+	}
+	for k, v := range listFields {
+		if v.IsNull() {
+			continue
+		}
+
+		var values []string
+		resp.Diagnostics.Append(v.ElementsAs(ctx, &values, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(values) > 0 {
+			requestData[k] = strings.Join(values, ",")
+		}
+	}
+
+	tflog.Debug(ctx, "Issuing certificate with PKI", map[string]interface{}{
+		"path": path,
+	})
+
+	secret, err := c.Logical().WriteWithContext(ctx, path, requestData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error issuing certificate with Vault",
+			fmt.Sprintf("Error issuing certificate at path %q: %s", path, err),
+		)
+		return
+	}
+
+	if data.SetWrapInfo(secret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
+	if secret == nil {
+		resp.Diagnostics.AddError(
+			"No response from certificate endpoint",
+			fmt.Sprintf("No response from certificate endpoint at path %q", path),
+		)
+		return
+	}
+
+	data.Certificate = stringValue(secret.Data, consts.FieldCertificate)
+	data.IssuingCA = stringValue(secret.Data, consts.FieldIssuingCA)
+	data.PrivateKey = stringValue(secret.Data, consts.FieldPrivateKey)
+	data.PrivateKeyType = stringValue(secret.Data, consts.FieldPrivateKeyType)
+	data.SerialNumber = stringValue(secret.Data, consts.FieldSerialNumber)
+
+	data.CAChain = types.StringNull()
+	if v, ok := secret.Data[consts.FieldCAChain].([]interface{}); ok {
+		chain := make([]string, 0, len(v))
+		for _, c := range v {
+			chain = append(chain, c.(string))
+		}
+		data.CAChain = types.StringValue(strings.Join(chain, "\n"))
+	}
+
+	data.Expiration = types.Int64Null()
+	if v, ok := secret.Data[consts.FieldExpiration].(json.Number); ok {
+		expiration, err := v.Int64()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected API response",
+				fmt.Sprintf("error parsing %q: %s", consts.FieldExpiration, err),
+			)
+			return
+		}
+		data.Expiration = types.Int64Value(expiration)
+	}
+	tflog.Debug(ctx, "Successfully issued certificate with PKI", map[string]any{
+		"path":          path,
+		"serial_number": data.SerialNumber.ValueString(),
+	})
+
+	if data.RevokeOnClose.ValueBool() {
+		privateData := pkiCertPrivateData{
+			Namespace:    data.Namespace.ValueString(),
+			Mount:        mount,
+			SerialNumber: data.SerialNumber.ValueString(),
+		}
+		if data.RevokeWithKey.ValueBool() {
+			privateData.PrivateKey = data.PrivateKey.ValueString()
+		}
+
+		privateBytes, err := json.Marshal(privateData)
+		if err != nil {
+			resp.Diagnostics.AddError("Error marshalling private data", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, pkiCertPrivateDataKey, privateBytes)...)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Close revokes the certificate if revoke_on_close is set.
+func (r *PKISecretBackendCertEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateBytes, diags := req.Private.GetKey(ctx, pkiCertPrivateDataKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateBytes == nil {
+		return
+	}
+
+	var privateData pkiCertPrivateData
+	if err := json.Unmarshal(privateBytes, &privateData); err != nil {
+		resp.Diagnostics.AddError("Unable to unmarshal private data", err.Error())
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), privateData.Namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	tflog.Debug(ctx, "Revoking certificate", map[string]any{
+		"mount":         privateData.Mount,
+		"serial_number": privateData.SerialNumber,
+	})
+
+	if err := pkiutil.RevokeCertificate(ctx, c, privateData.Mount, privateData.SerialNumber, privateData.PrivateKey); err != nil {
+		resp.Diagnostics.AddError(
+			"Error revoking certificate",
+			fmt.Sprintf("Error revoking certificate with serial number %q on PKI secret backend %q: %s",
+				privateData.SerialNumber, privateData.Mount, err),
+		)
+	}
+}
+
+func stringValue(data map[string]interface{}, key string) types.String {
+	if v, ok := data[key].(string); ok {
+		return types.StringValue(v)
+	}
+
+	return types.StringNull()
+}
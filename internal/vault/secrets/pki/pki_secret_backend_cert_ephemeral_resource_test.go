@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pki_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+var (
+	regexpPEMCertificate = regexp.MustCompile(`^-----BEGIN CERTIFICATE-----`)
+	regexpPEMPrivateKey  = regexp.MustCompile(`^-----BEGIN (RSA |EC )?PRIVATE KEY-----`)
+)
+
+func TestAccPKISecretBackendCertEphemeral(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-pki")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPKISecretBackendCertEphemeralConfig(mount, ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("certificate"), knownvalue.StringRegexp(regexpPEMCertificate)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("issuing_ca"), knownvalue.StringRegexp(regexpPEMCertificate)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("private_key"), knownvalue.StringRegexp(regexpPEMPrivateKey)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("private_key_type"), knownvalue.StringExact("rsa")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("serial_number"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("expiration"), knownvalue.NotNull()),
+				},
+				Check: testAccPKICheckRevokedCount(mount, 0),
+			},
+			{
+				Config: testAccPKISecretBackendCertEphemeralConfig(mount, `revoke_on_close = true
+  revoke_with_key = true`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("certificate"), knownvalue.StringRegexp(regexpPEMCertificate)),
+				},
+				Check: testAccPKICheckRevokedCount(mount, 1),
+			},
+			{
+				Config:      testAccPKISecretBackendCertEphemeralConfig(mount, `revoke_with_key = true`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+// testAccPKICheckRevokedCount checks that at least min certificates were
+// revoked on the mount.
+func testAccPKICheckRevokedCount(mount string, min int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client, err := api.NewClient(api.DefaultConfig())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Logical().List(mount + "/certs/revoked")
+		if err != nil {
+			return err
+		}
+
+		var count int
+		if resp != nil {
+			if keys, ok := resp.Data["keys"].([]interface{}); ok {
+				count = len(keys)
+			}
+		}
+
+		if count < min || (min == 0 && count != 0) {
+			return fmt.Errorf("expected at least %d revoked certificates on %q, got %d", min, mount, count)
+		}
+
+		return nil
+	}
+}
+
+func testAccPKISecretBackendCertEphemeralConfig(mount, args string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test.path
+  type        = "internal"
+  common_name = "test Root CA"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend          = vault_mount.test.path
+  name             = "test"
+  allowed_domains  = ["example.com"]
+  allow_subdomains = true
+  key_type         = "rsa"
+  key_bits         = 2048
+}
+
+ephemeral "vault_pki_secret_backend_cert" "test" {
+  mount_id    = vault_pki_secret_backend_root_cert.test.id
+  mount       = vault_mount.test.path
+  name        = vault_pki_secret_backend_role.test.name
+  common_name = "www.example.com"
+  alt_names   = ["api.example.com"]
+  ttl         = "1h"
+  %s
+}
+
+provider "echo" {
+  data = ephemeral.vault_pki_secret_backend_cert.test
+}
+
+resource "echo" "test" {}
+`, mount, args)
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pki
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+var _ list.ListResourceWithConfigure = &PKISecretBackendRoleListResource{}
+var _ list.ListResourceWithRawV5Schemas = &PKISecretBackendRoleListResource{}
+
+// NewPKISecretBackendRoleListResource returns the implementation for this list resource
+// to be used by the Terraform Plugin Framework provider.
+func NewPKISecretBackendRoleListResource() list.ListResource {
+	return &PKISecretBackendRoleListResource{}
+}
+
+// PKISecretBackendRoleListResource lists the roles of a PKI secrets engine as
+// vault_pki_secret_backend_role resources.
+type PKISecretBackendRoleListResource struct {
+	base.SDKv2ListResource
+}
+
+// Metadata defines the list resource name, it matches the listed resource.
+func (r *PKISecretBackendRoleListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pki_secret_backend_role"
+}
+
+// ListResourceConfigSchema defines the list resource configuration schema.
+func (r *PKISecretBackendRoleListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the roles of a PKI secrets engine.",
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Path where the PKI secrets engine is mounted.",
+			},
+		},
+	}
+
+	base.MustAddBaseListSchema(&resp.Schema)
+}
+
+// List streams the roles of the mount.
+func (r *PKISecretBackendRoleListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data base.MountListModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		stream.Results = base.ListResultsError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	objects, err := base.ListMountRoles(ctx, c, data.Mount.ValueString())
+	if err != nil {
+		stream.Results = base.ListResultsError(errutil.VaultReadErr(err))
+		return
+	}
+
+	stream.Results = r.Results(ctx, req, data.Namespace.ValueString(), objects)
+}
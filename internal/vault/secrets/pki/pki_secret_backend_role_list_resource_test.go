@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pki_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccPKISecretBackendRoleListResource(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-pki")
+	name := acctest.RandomWithPrefix("role")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend         = vault_mount.test.path
+  name            = "%s"
+  allow_localhost = true
+}
+`, mount, name),
+			},
+			{
+				Query: true,
+				Config: fmt.Sprintf(`
+list "vault_pki_secret_backend_role" "test" {
+  provider = vault
+
+  config {
+    mount = "%s"
+  }
+}
+`, mount),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("vault_pki_secret_backend_role.test", 1),
+					querycheck.ExpectIdentity("vault_pki_secret_backend_role.test", map[string]knownvalue.Check{
+						consts.FieldID:        knownvalue.StringExact(mount + "/roles/" + name),
+						consts.FieldNamespace: knownvalue.Null(),
+					}),
+				},
+			},
+		},
+	})
+}
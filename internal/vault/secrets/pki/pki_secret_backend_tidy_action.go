@@ -0,0 +1,275 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pki
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+const (
+	tidyStateRunning    = "Running"
+	tidyStateCancelling = "Cancelling"
+	tidyStateError      = "Error"
+	tidyStateCancelled  = "Cancelled"
+
+	defaultTidyPollInterval = 5
+)
+
+var _ action.ActionWithConfigure = &PKISecretBackendTidyAction{}
+
+// NewPKISecretBackendTidyAction returns the implementation for this action
+var NewPKISecretBackendTidyAction = func() action.Action {
+	return &PKISecretBackendTidyAction{}
+}
+
+// PKISecretBackendTidyAction starts a tidy operation on a PKI secrets engine
+// and optionally waits for it to complete.
+type PKISecretBackendTidyAction struct {
+	base.ActionWithConfigure
+}
+
+// PKISecretBackendTidyModel describes the Terraform action data model
+type PKISecretBackendTidyModel struct {
+	base.BaseActionModel
+
+	Mount                             types.String `tfsdk:"mount"`
+	TidyCertStore                     types.Bool   `tfsdk:"tidy_cert_store"`
+	TidyRevokedCerts                  types.Bool   `tfsdk:"tidy_revoked_certs"`
+	TidyRevokedCertIssuerAssociations types.Bool   `tfsdk:"tidy_revoked_cert_issuer_associations"`
+	TidyExpiredIssuers                types.Bool   `tfsdk:"tidy_expired_issuers"`
+	TidyMoveLegacyCaBundle            types.Bool   `tfsdk:"tidy_move_legacy_ca_bundle"`
+	TidyCrossClusterRevokedCerts      types.Bool   `tfsdk:"tidy_cross_cluster_revoked_certs"`
+	TidyCertMetadata                  types.Bool   `tfsdk:"tidy_cert_metadata"`
+	TidyCmpv2NonceStore               types.Bool   `tfsdk:"tidy_cmpv2_nonce_store"`
+	TidyAcme                          types.Bool   `tfsdk:"tidy_acme"`
+	TidyRevocationQueue               types.Bool   `tfsdk:"tidy_revocation_queue"`
+	SafetyBuffer                      types.String `tfsdk:"safety_buffer"`
+	IssuerSafetyBuffer                types.String `tfsdk:"issuer_safety_buffer"`
+	AcmeAccountSafetyBuffer           types.String `tfsdk:"acme_account_safety_buffer"`
+	PauseDuration                     types.String `tfsdk:"pause_duration"`
+	WaitForCompletion                 types.Bool   `tfsdk:"wait_for_completion"`
+	PollInterval                      types.Int64  `tfsdk:"poll_interval"`
+}
+
+func (a *PKISecretBackendTidyAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pki_secret_backend_tidy"
+}
+
+func (a *PKISecretBackendTidyAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Runs a tidy operation on a PKI secrets engine.",
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the PKI secrets engine is mounted.",
+				Required:            true,
+			},
+			consts.FieldTidyCertStore: schema.BoolAttribute{
+				MarkdownDescription: "Set to true to enable tidying up the certificate store.",
+				Optional:            true,
+			},
+			consts.FieldTidyRevokedCerts: schema.BoolAttribute{
+				MarkdownDescription: "Set to true to remove all invalid and expired certificates from storage.",
+				Optional:            true,
+			},
+			consts.FieldTidyRevokedCertIssuerAssociations: schema.BoolAttribute{
+				MarkdownDescription: "Set to true to validate issuer associations on revocation entries.",
+				Optional:            true,
+			},
+			consts.FieldTidyExpiredIssuers: schema.BoolAttribute{
+				MarkdownDescription: "Set to true to automatically remove expired issuers past the `issuer_safety_buffer`.",
+				Optional:            true,
+			},
+			consts.FieldTidyMoveLegacyCaBundle: schema.BoolAttribute{
+				MarkdownDescription: "Set to true to move the legacy `ca_bundle` from `/config/ca_bundle` to `/config/ca_bundle.bak`.",
+				Optional:            true,
+			},
+			consts.FieldTidyCrossClusterRevokedCerts: schema.BoolAttribute{
+				MarkdownDescription: "Set to true to enable tidying up the cross-cluster revoked certificate store.",
+				Optional:            true,
+			},
+			consts.FieldTidyCertMetadata: schema.BoolAttribute{
+				MarkdownDescription: "Set to true to enable tidying up certificate metadata.",
+				Optional:            true,
+			},
+			consts.FieldTidyCmpv2NonceStore: schema.BoolAttribute{
+				MarkdownDescription: "Set to true to enable tidying up the CMPv2 nonce store.",
+				Optional:            true,
+			},
+			consts.FieldTidyAcme: schema.BoolAttribute{
+				MarkdownDescription: "Set to true to enable tidying ACME accounts, orders and authorizations.",
+				Optional:            true,
+			},
+			consts.FieldTidyRevocationQueue: schema.BoolAttribute{
+				MarkdownDescription: "Set to true to remove stale revocation queue entries.",
+				Optional:            true,
+			},
+			consts.FieldSafetyBuffer: schema.StringAttribute{
+				MarkdownDescription: "The amount of extra time that must have passed beyond certificate expiration " +
+					"before it is removed from the backend storage and/or revocation list.",
+				Optional: true,
+			},
+			consts.FieldIssuerSafetyBuffer: schema.StringAttribute{
+				MarkdownDescription: "The amount of extra time that must have passed beyond issuer's expiration " +
+					"before it is removed from the backend storage.",
+				Optional: true,
+			},
+			consts.FieldAcmeAccountSafetyBuffer: schema.StringAttribute{
+				MarkdownDescription: "The amount of time that must pass after creation that an account with " +
+					"no orders is marked revoked.",
+				Optional: true,
+			},
+			consts.FieldPauseDuration: schema.StringAttribute{
+				MarkdownDescription: "The amount of time to wait between processing certificates.",
+				Optional:            true,
+			},
+			consts.FieldWaitForCompletion: schema.BoolAttribute{
+				MarkdownDescription: "Wait for the tidy operation to finish, reporting its progress. Defaults to `true`.",
+				Optional:            true,
+			},
+			consts.FieldPollInterval: schema.Int64Attribute{
+				MarkdownDescription: "Interval in seconds between tidy status checks when waiting for completion. Defaults to `5`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+	base.MustAddBaseActionSchema(&resp.Schema)
+}
+
+func (a *PKISecretBackendTidyAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data PKISecretBackendTidyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, a.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	mount := data.Mount.ValueString()
+	path := fmt.Sprintf("%s/tidy", mount)
+	base.SendProgress(resp, "Starting tidy of PKI secrets engine %q", mount)
+
+	if _, err := c.Logical().WriteWithContext(ctx, path, data.tidyRequest()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error starting PKI tidy",
+			fmt.Sprintf("Error starting tidy at path %q: %s", path, err),
+		)
+		return
+	}
+
+	if !data.WaitForCompletion.IsNull() && !data.WaitForCompletion.ValueBool() {
+		base.SendProgress(resp, "Started tidy of PKI secrets engine %q", mount)
+		return
+	}
+
+	interval := int64(defaultTidyPollInterval)
+	if !data.PollInterval.IsNull() {
+		interval = data.PollInterval.ValueInt64()
+	}
+
+	if err := waitForTidy(ctx, c, mount, time.Duration(interval)*time.Second, resp); err != nil {
+		resp.Diagnostics.AddError(
+			"Error running PKI tidy",
+			fmt.Sprintf("Error running tidy of PKI secrets engine %q: %s", mount, err),
+		)
+	}
+}
+
+// tidyRequest returns the tidy request parameters that are set in the
+// configuration.
+func (m *PKISecretBackendTidyModel) tidyRequest() map[string]interface{} {
+	result := map[string]interface{}{}
+
+	for k, v := range map[string]types.Bool{
+		consts.FieldTidyCertStore:                     m.TidyCertStore,
+		consts.FieldTidyRevokedCerts:                  m.TidyRevokedCerts,
+		consts.FieldTidyRevokedCertIssuerAssociations: m.TidyRevokedCertIssuerAssociations,
+		consts.FieldTidyExpiredIssuers:                m.TidyExpiredIssuers,
+		consts.FieldTidyMoveLegacyCaBundle:            m.TidyMoveLegacyCaBundle,
+		consts.FieldTidyCrossClusterRevokedCerts:      m.TidyCrossClusterRevokedCerts,
+		consts.FieldTidyCertMetadata:                  m.TidyCertMetadata,
+		consts.FieldTidyCmpv2NonceStore:               m.TidyCmpv2NonceStore,
+		consts.FieldTidyAcme:                          m.TidyAcme,
+		consts.FieldTidyRevocationQueue:               m.TidyRevocationQueue,
+	} {
+		if !v.IsNull() {
+			result[k] = v.ValueBool()
+		}
+	}
+
+	for k, v := range map[string]types.String{
+		consts.FieldSafetyBuffer:            m.SafetyBuffer,
+		consts.FieldIssuerSafetyBuffer:      m.IssuerSafetyBuffer,
+		consts.FieldAcmeAccountSafetyBuffer: m.AcmeAccountSafetyBuffer,
+		consts.FieldPauseDuration:           m.PauseDuration,
+	} {
+		if !v.IsNull() {
+			result[k] = v.ValueString()
+		}
+	}
+
+	return result
+}
+
+// waitForTidy polls the tidy status of the mount until the tidy operation is
+// no longer running, the progress is reported on each poll.
+func waitForTidy(ctx context.Context, c *api.Client, mount string, interval time.Duration, resp *action.InvokeResponse) error {
+	path := fmt.Sprintf("%s/tidy-status", mount)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		secret, err := c.Logical().ReadWithContext(ctx, path)
+		if err != nil {
+			return fmt.Errorf("error reading tidy status at path %q: %w", path, err)
+		}
+		if secret == nil {
+			return fmt.Errorf("no tidy status found at path %q", path)
+		}
+
+		state, _ := secret.Data["state"].(string)
+		message, _ := secret.Data["message"].(string)
+
+		switch state {
+		case tidyStateRunning, tidyStateCancelling:
+			base.SendProgress(resp, "Tidy of PKI secrets engine %q is %s: %s", mount, state, message)
+		case tidyStateError, tidyStateCancelled:
+			if e, ok := secret.Data["error"].(string); ok && e != "" {
+				return fmt.Errorf("tidy %s: %s", state, e)
+			}
+			return fmt.Errorf("tidy %s: %s", state, message)
+		default:
+			base.SendProgress(resp, "Tidy of PKI secrets engine %q is %s, deleted %v certificates and %v revoked certificates",
+				mount, state, secret.Data["cert_store_deleted_count"], secret.Data["revoked_cert_deleted_count"])
+			return nil
+		}
+	}
+}
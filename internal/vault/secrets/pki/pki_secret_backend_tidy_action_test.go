@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pki_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccPKISecretBackendTidyAction(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-pki")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+action "vault_pki_secret_backend_tidy" "test" {
+  config {
+    mount              = vault_mount.test.path
+    tidy_cert_store    = true
+    tidy_revoked_certs = true
+    safety_buffer      = "1s"
+    poll_interval      = 1
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = vault_mount.test.path
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.vault_pki_secret_backend_tidy.test]
+    }
+  }
+}
+`, mount),
+				Check: testAccPKICheckTidyState(mount, "Finished"),
+			},
+		},
+	})
+}
+
+func testAccPKICheckTidyState(mount, expected string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client, err := api.NewClient(api.DefaultConfig())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Logical().Read(mount + "/tidy-status")
+		if err != nil {
+			return err
+		}
+		if resp == nil {
+			return fmt.Errorf("no tidy status found on %q", mount)
+		}
+
+		if state := resp.Data["state"]; state != expected {
+			return fmt.Errorf("expected tidy state %q, got %q", expected, state)
+		}
+
+		return nil
+	}
+}
@@ -98,6 +98,12 @@ func (s *SpiffeSecretBackendMintJwtResource) Open(ctx context.Context, req ephem
 		return
 	}
 
+	vaultClient, err = data.WrappingClient(vaultClient)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
 	vaultRequest, diagErr := s.getApiModel(ctx, &data)
 	if diagErr.HasError() {
 		resp.Diagnostics.Append(diagErr...)
@@ -115,6 +121,12 @@ func (s *SpiffeSecretBackendMintJwtResource) Open(ctx context.Context, req ephem
 		resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
 		return
 	}
+
+	if data.SetWrapInfo(vaultResp) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
 	if vaultResp == nil {
 		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
 		return
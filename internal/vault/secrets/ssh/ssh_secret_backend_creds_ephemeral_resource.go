@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/lease"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
+)
+
+// Ensure the implementation satisfies the ephemeral.EphemeralResource interface
+var _ ephemeral.EphemeralResource = &SSHSecretBackendCredsEphemeralResource{}
+
+// NewSSHSecretBackendCredsEphemeralResource returns the implementation for this resource
+var NewSSHSecretBackendCredsEphemeralResource = func() ephemeral.EphemeralResource {
+	return &SSHSecretBackendCredsEphemeralResource{}
+}
+
+// SSHSecretBackendCredsEphemeralResource implements the methods that define this resource
+type SSHSecretBackendCredsEphemeralResource struct {
+	lease.EphemeralResourceWithLease
+}
+
+// SSHSecretBackendCredsModel describes the Terraform resource data model
+type SSHSecretBackendCredsModel struct {
+	base.BaseModelEphemeral
+
+	Mount          types.String `tfsdk:"mount"`
+	Name           types.String `tfsdk:"name"`
+	IP             types.String `tfsdk:"ip"`
+	Username       types.String `tfsdk:"username"`
+	Key            types.String `tfsdk:"key"`
+	KeyType        types.String `tfsdk:"key_type"`
+	Port           types.Int64  `tfsdk:"port"`
+	LeaseID        types.String `tfsdk:"lease_id"`
+	LeaseDuration  types.Int64  `tfsdk:"lease_duration"`
+	LeaseStartTime types.String `tfsdk:"lease_start_time"`
+	LeaseRenewable types.Bool   `tfsdk:"lease_renewable"`
+}
+
+// SSHSecretBackendCredsAPIModel describes the Vault API data model
+type SSHSecretBackendCredsAPIModel struct {
+	IP       string `json:"ip" mapstructure:"ip"`
+	Username string `json:"username" mapstructure:"username"`
+	Key      string `json:"key" mapstructure:"key"`
+	KeyType  string `json:"key_type" mapstructure:"key_type"`
+	Port     int64  `json:"port" mapstructure:"port"`
+}
+
+func (r *SSHSecretBackendCredsEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Mount path for the SSH secrets engine in Vault.",
+				Required:            true,
+			},
+			consts.FieldName: schema.StringAttribute{
+				MarkdownDescription: "Name of the OTP role to create the credentials with.",
+				Required:            true,
+			},
+			consts.FieldIP: schema.StringAttribute{
+				MarkdownDescription: "IP address of the remote host.",
+				Required:            true,
+			},
+			consts.FieldUsername: schema.StringAttribute{
+				MarkdownDescription: "Username on the remote host. The role's `default_user` is used if not set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The one-time password.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			consts.FieldKeyType: schema.StringAttribute{
+				MarkdownDescription: "Type of the credentials, always `otp`.",
+				Computed:            true,
+			},
+			consts.FieldPort: schema.Int64Attribute{
+				MarkdownDescription: "SSH port of the remote host, from the role's `port`.",
+				Computed:            true,
+			},
+			consts.FieldLeaseID: schema.StringAttribute{
+				MarkdownDescription: "Lease identifier assigned by vault.",
+				Computed:            true,
+			},
+			consts.FieldLeaseDuration: schema.Int64Attribute{
+				MarkdownDescription: "Lease duration in seconds relative to the time in lease_start_time.",
+				Computed:            true,
+			},
+			consts.FieldLeaseStartTime: schema.StringAttribute{
+				MarkdownDescription: "Time at which the lease was read, using the clock of the system where Terraform was running.",
+				Computed:            true,
+			},
+			consts.FieldLeaseRenewable: schema.BoolAttribute{
+				MarkdownDescription: "True if the duration of this lease can be extended through renewal.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: "Creates a one-time password with an OTP role of the SSH secrets engine. " +
+			"The password is revoked when Terraform closes the ephemeral resource.",
+	}
+
+	base.MustAddBaseEphemeralSchema(&resp.Schema)
+}
+
+func (r *SSHSecretBackendCredsEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_secret_backend_creds"
+}
+
+func (r *SSHSecretBackendCredsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data SSHSecretBackendCredsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	payload := map[string]interface{}{
+		consts.FieldIP: data.IP.ValueString(),
+	}
+	if data.Username.ValueString() != "" {
+		payload[consts.FieldUsername] = data.Username.ValueString()
+	}
+
+	path := r.path(data.Mount.ValueString(), data.Name.ValueString())
+	secret, err := c.Logical().WriteWithContext(ctx, path, payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating SSH credentials", err.Error())
+		return
+	}
+
+	if data.SetWrapInfo(secret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
+	if secret == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+
+	var credsResp SSHSecretBackendCredsAPIModel
+	if err := model.ToAPIModel(secret.Data, &credsResp); err != nil {
+		resp.Diagnostics.AddError("Unable to translate Vault response data", err.Error())
+		return
+	}
+
+	data.IP = types.StringValue(credsResp.IP)
+	data.Username = types.StringValue(credsResp.Username)
+	data.Key = types.StringValue(credsResp.Key)
+	data.KeyType = types.StringValue(credsResp.KeyType)
+	data.Port = types.Int64Value(credsResp.Port)
+
+	data.LeaseID = types.StringValue(secret.LeaseID)
+	data.LeaseDuration = types.Int64Value(int64(secret.LeaseDuration))
+	data.LeaseStartTime = types.StringValue(time.Now().Format(time.RFC3339))
+	data.LeaseRenewable = types.BoolValue(secret.Renewable)
+
+	r.SetLease(ctx, resp, data.Namespace.ValueString(), secret)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *SSHSecretBackendCredsEphemeralResource) path(mount, name string) string {
+	return fmt.Sprintf("%s/creds/%s", strings.Trim(mount, "/"), name)
+}
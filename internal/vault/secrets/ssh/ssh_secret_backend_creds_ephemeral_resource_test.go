@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssh_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccSSHSecretBackendCredsEphemeralResource(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-ssh")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSHSecretBackendOTPConfig(mount) + `
+ephemeral "vault_ssh_secret_backend_creds" "test" {
+  mount    = vault_mount.test.path
+  mount_id = vault_ssh_secret_backend_role.test.id
+  name     = vault_ssh_secret_backend_role.test.name
+  ip       = "10.1.2.3"
+}
+
+provider "echo" {
+  data = ephemeral.vault_ssh_secret_backend_creds.test
+}
+
+resource "echo" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("key"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("key_type"), knownvalue.StringExact("otp")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("username"), knownvalue.StringExact("ubuntu")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("ip"), knownvalue.StringExact("10.1.2.3")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("port"), knownvalue.Int64Exact(2222)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("lease_id"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testAccSSHSecretBackendOTPConfig(mount string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "ssh"
+}
+
+resource "vault_ssh_secret_backend_role" "test" {
+  backend      = vault_mount.test.path
+  name         = "otp"
+  key_type     = "otp"
+  default_user = "ubuntu"
+  cidr_list    = "10.0.0.0/8"
+  port         = 2222
+}
+`, mount)
+}
@@ -0,0 +1,205 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
+)
+
+// Ensure the implementation satisfies the ephemeral.EphemeralResource interface
+var _ ephemeral.EphemeralResource = &SSHSecretBackendSignEphemeralResource{}
+
+// NewSSHSecretBackendSignEphemeralResource returns the implementation for this resource
+var NewSSHSecretBackendSignEphemeralResource = func() ephemeral.EphemeralResource {
+	return &SSHSecretBackendSignEphemeralResource{}
+}
+
+// SSHSecretBackendSignEphemeralResource implements the methods that define this resource
+type SSHSecretBackendSignEphemeralResource struct {
+	base.EphemeralResourceWithConfigure
+}
+
+// SSHSecretBackendSignModel describes the Terraform resource data model
+type SSHSecretBackendSignModel struct {
+	base.BaseModelEphemeral
+
+	Mount           types.String `tfsdk:"mount"`
+	Name            types.String `tfsdk:"name"`
+	PublicKey       types.String `tfsdk:"public_key"`
+	TTL             types.String `tfsdk:"ttl"`
+	ValidPrincipals types.String `tfsdk:"valid_principals"`
+	CertType        types.String `tfsdk:"cert_type"`
+	KeyID           types.String `tfsdk:"key_id"`
+	CriticalOptions types.Map    `tfsdk:"critical_options"`
+	Extensions      types.Map    `tfsdk:"extensions"`
+	SerialNumber    types.String `tfsdk:"serial_number"`
+	SignedKey       types.String `tfsdk:"signed_key"`
+}
+
+// SSHSecretBackendSignAPIModel describes the Vault API data model
+type SSHSecretBackendSignAPIModel struct {
+	SerialNumber string `json:"serial_number" mapstructure:"serial_number"`
+	SignedKey    string `json:"signed_key" mapstructure:"signed_key"`
+}
+
+func (r *SSHSecretBackendSignEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Mount path for the SSH secrets engine in Vault.",
+				Required:            true,
+			},
+			consts.FieldName: schema.StringAttribute{
+				MarkdownDescription: "Name of the role to sign the public key with.",
+				Required:            true,
+			},
+			consts.FieldPublicKey: schema.StringAttribute{
+				MarkdownDescription: "The SSH public key to sign.",
+				Required:            true,
+			},
+			consts.FieldTTL: schema.StringAttribute{
+				MarkdownDescription: "Requested TTL of the certificate. Cannot be greater than the role's `max_ttl`. " +
+					"The role's `ttl` is used if not set.",
+				Optional: true,
+			},
+			consts.FieldValidPrincipals: schema.StringAttribute{
+				MarkdownDescription: "Comma separated list of the principals, either usernames or hostnames, " +
+					"that the certificate is signed for.",
+				Optional: true,
+			},
+			consts.FieldCertType: schema.StringAttribute{
+				MarkdownDescription: "Type of certificate to create, either `user` or `host`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("user", "host"),
+				},
+			},
+			consts.FieldKeyID: schema.StringAttribute{
+				MarkdownDescription: "Key ID of the certificate. The display name of the token is used if not set.",
+				Optional:            true,
+			},
+			consts.FieldCriticalOptions: schema.MapAttribute{
+				MarkdownDescription: "Critical options of the certificate.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			consts.FieldExtensions: schema.MapAttribute{
+				MarkdownDescription: "Extensions of the certificate.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			consts.FieldSerialNumber: schema.StringAttribute{
+				MarkdownDescription: "Serial number of the certificate.",
+				Computed:            true,
+			},
+			consts.FieldSignedKey: schema.StringAttribute{
+				MarkdownDescription: "The signed certificate.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: "Signs an SSH public key with a role of the SSH secrets engine, " +
+			"without storing the certificate in state.",
+	}
+
+	base.MustAddBaseEphemeralSchema(&resp.Schema)
+}
+
+func (r *SSHSecretBackendSignEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_secret_backend_sign"
+}
+
+func (r *SSHSecretBackendSignEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data SSHSecretBackendSignModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	payload := map[string]interface{}{
+		consts.FieldPublicKey: data.PublicKey.ValueString(),
+	}
+	for k, v := range map[string]types.String{
+		consts.FieldTTL:             data.TTL,
+		consts.FieldValidPrincipals: data.ValidPrincipals,
+		consts.FieldCertType:        data.CertType,
+		consts.FieldKeyID:           data.KeyID,
+	} {
+		if v.ValueString() != "" {
+			payload[k] = v.ValueString()
+		}
+	}
+	for k, v := range map[string]types.Map{
+		consts.FieldCriticalOptions: data.CriticalOptions,
+		consts.FieldExtensions:      data.Extensions,
+	} {
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		var m map[string]string
+		resp.Diagnostics.Append(v.ElementsAs(ctx, &m, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		payload[k] = m
+	}
+
+	path := r.path(data.Mount.ValueString(), data.Name.ValueString())
+	secret, err := c.Logical().WriteWithContext(ctx, path, payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Error signing SSH public key", err.Error())
+		return
+	}
+
+	if data.SetWrapInfo(secret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
+	if secret == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+
+	var signResp SSHSecretBackendSignAPIModel
+	if err := model.ToAPIModel(secret.Data, &signResp); err != nil {
+		resp.Diagnostics.AddError("Unable to translate Vault response data", err.Error())
+		return
+	}
+
+	data.SerialNumber = types.StringValue(signResp.SerialNumber)
+	data.SignedKey = types.StringValue(signResp.SignedKey)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *SSHSecretBackendSignEphemeralResource) path(mount, name string) string {
+	return fmt.Sprintf("%s/sign/%s", strings.Trim(mount, "/"), name)
+}
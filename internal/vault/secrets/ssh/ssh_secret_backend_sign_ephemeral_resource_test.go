@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssh_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+const testPublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIKQ3V/rfN0gELCRRlC64wDoyWWKj3NKPoc6HZ3a5EZYu user@example.com"
+
+func TestAccSSHSecretBackendSignEphemeralResource(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-ssh")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSHSecretBackendSignEphemeralConfig(mount),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("signed_key"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^ssh-ed25519-cert-v01@openssh.com `))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("serial_number"),
+						knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testAccSSHSecretBackendSignEphemeralConfig(mount string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "ssh"
+}
+
+resource "vault_ssh_secret_backend_ca" "test" {
+  backend              = vault_mount.test.path
+  generate_signing_key = true
+}
+
+resource "vault_ssh_secret_backend_role" "test" {
+  backend                 = vault_mount.test.path
+  name                    = "test"
+  key_type                = "ca"
+  allow_user_certificates = true
+  allowed_users           = "*"
+  allowed_extensions      = "permit-pty"
+  ttl                     = "1800"
+}
+
+ephemeral "vault_ssh_secret_backend_sign" "test" {
+  mount            = vault_mount.test.path
+  mount_id         = vault_ssh_secret_backend_role.test.id
+  name             = vault_ssh_secret_backend_role.test.name
+  public_key       = "%s"
+  valid_principals = "ubuntu"
+  ttl              = "5m"
+  extensions = {
+    permit-pty = ""
+  }
+}
+
+provider "echo" {
+  data = ephemeral.vault_ssh_secret_backend_sign.test
+}
+
+resource "echo" "test" {}
+`, mount, testPublicKey)
+}
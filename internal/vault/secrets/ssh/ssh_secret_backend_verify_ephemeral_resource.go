@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
+)
+
+// Ensure the implementation satisfies the ephemeral.EphemeralResource interface
+var _ ephemeral.EphemeralResource = &SSHSecretBackendVerifyEphemeralResource{}
+
+// NewSSHSecretBackendVerifyEphemeralResource returns the implementation for this resource
+var NewSSHSecretBackendVerifyEphemeralResource = func() ephemeral.EphemeralResource {
+	return &SSHSecretBackendVerifyEphemeralResource{}
+}
+
+// SSHSecretBackendVerifyEphemeralResource implements the methods that define this resource
+type SSHSecretBackendVerifyEphemeralResource struct {
+	base.EphemeralResourceWithConfigure
+}
+
+// SSHSecretBackendVerifyModel describes the Terraform resource data model
+type SSHSecretBackendVerifyModel struct {
+	base.BaseModelEphemeral
+
+	Mount    types.String `tfsdk:"mount"`
+	OTP      types.String `tfsdk:"otp"`
+	IP       types.String `tfsdk:"ip"`
+	Username types.String `tfsdk:"username"`
+	RoleName types.String `tfsdk:"role_name"`
+}
+
+// SSHSecretBackendVerifyAPIModel describes the Vault API data model
+type SSHSecretBackendVerifyAPIModel struct {
+	IP       string `json:"ip" mapstructure:"ip"`
+	Username string `json:"username" mapstructure:"username"`
+	RoleName string `json:"role_name" mapstructure:"role_name"`
+}
+
+func (r *SSHSecretBackendVerifyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Mount path for the SSH secrets engine in Vault.",
+				Required:            true,
+			},
+			consts.FieldOTP: schema.StringAttribute{
+				MarkdownDescription: "The one-time password to verify. A valid password is consumed by the verification.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			consts.FieldIP: schema.StringAttribute{
+				MarkdownDescription: "IP address of the remote host the password was created for.",
+				Computed:            true,
+			},
+			consts.FieldUsername: schema.StringAttribute{
+				MarkdownDescription: "Username the password was created for.",
+				Computed:            true,
+			},
+			consts.FieldRoleName: schema.StringAttribute{
+				MarkdownDescription: "Name of the role the password was created with.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: "Verifies a one-time password created with an OTP role of the SSH secrets engine.",
+	}
+
+	base.MustAddBaseEphemeralSchema(&resp.Schema)
+}
+
+func (r *SSHSecretBackendVerifyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_secret_backend_verify"
+}
+
+func (r *SSHSecretBackendVerifyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data SSHSecretBackendVerifyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	path := fmt.Sprintf("%s/verify", strings.Trim(data.Mount.ValueString(), "/"))
+	secret, err := c.Logical().WriteWithContext(ctx, path, map[string]interface{}{
+		consts.FieldOTP: data.OTP.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error verifying SSH one-time password", err.Error())
+		return
+	}
+
+	if data.SetWrapInfo(secret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
+	if secret == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+
+	var verifyResp SSHSecretBackendVerifyAPIModel
+	if err := model.ToAPIModel(secret.Data, &verifyResp); err != nil {
+		resp.Diagnostics.AddError("Unable to translate Vault response data", err.Error())
+		return
+	}
+
+	data.IP = types.StringValue(verifyResp.IP)
+	data.Username = types.StringValue(verifyResp.Username)
+	data.RoleName = types.StringValue(verifyResp.RoleName)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssh_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccSSHSecretBackendVerifyEphemeralResource(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-ssh")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSHSecretBackendOTPConfig(mount) + `
+ephemeral "vault_ssh_secret_backend_creds" "test" {
+  mount    = vault_mount.test.path
+  mount_id = vault_ssh_secret_backend_role.test.id
+  name     = vault_ssh_secret_backend_role.test.name
+  ip       = "10.1.2.3"
+}
+
+ephemeral "vault_ssh_secret_backend_verify" "test" {
+  mount = vault_mount.test.path
+  otp   = ephemeral.vault_ssh_secret_backend_creds.test.key
+}
+
+provider "echo" {
+  data = ephemeral.vault_ssh_secret_backend_verify.test
+}
+
+resource "echo" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("username"), knownvalue.StringExact("ubuntu")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("ip"), knownvalue.StringExact("10.1.2.3")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("role_name"), knownvalue.StringExact("otp")),
+				},
+			},
+		},
+	})
+}
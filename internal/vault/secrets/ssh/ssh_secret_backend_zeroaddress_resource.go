@@ -0,0 +1,261 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
+)
+
+const sshZeroAddressPath = "config/zeroaddress"
+
+// Ensure the implementation satisfies the resource.ResourceWithImportState interface
+var (
+	_ resource.ResourceWithImportState = &SSHSecretBackendZeroAddressResource{}
+	_ resource.ResourceWithModifyPlan  = &SSHSecretBackendZeroAddressResource{}
+)
+
+// NewSSHSecretBackendZeroAddressResource returns the implementation for this resource to be
+// imported by the Terraform Plugin Framework provider
+func NewSSHSecretBackendZeroAddressResource() resource.Resource {
+	return &SSHSecretBackendZeroAddressResource{}
+}
+
+// SSHSecretBackendZeroAddressResource implements the methods that define this resource
+type SSHSecretBackendZeroAddressResource struct {
+	base.ResourceWithConfigure
+}
+
+// SSHSecretBackendZeroAddressModel describes the Terraform resource data model
+type SSHSecretBackendZeroAddressModel struct {
+	base.BaseModel
+
+	Mount types.String `tfsdk:"mount"`
+	Roles types.Set    `tfsdk:"roles"`
+}
+
+// SSHSecretBackendZeroAddressAPIModel describes the Vault API data model
+type SSHSecretBackendZeroAddressAPIModel struct {
+	Roles []string `json:"roles" mapstructure:"roles"`
+}
+
+func (r *SSHSecretBackendZeroAddressResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_secret_backend_zeroaddress"
+}
+
+func (r *SSHSecretBackendZeroAddressResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Mount path for the SSH secrets engine in Vault.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			consts.FieldRoles: schema.SetAttribute{
+				MarkdownDescription: "Names of the roles that can create credentials for any IP address, " +
+					"regardless of their `cidr_list`.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+		MarkdownDescription: "Manages the roles of the SSH secrets engine that apply to all IP addresses.",
+	}
+
+	base.MustAddBaseSchema(&resp.Schema)
+}
+
+// ModifyPlan checks the capabilities of the provider token on the config path
+// of the mount, see the plan_capability_check provider argument.
+func (r *SSHSecretBackendZeroAddressResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var mount types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(consts.FieldMount), &mount)...)
+	if resp.Diagnostics.HasError() || mount.IsUnknown() {
+		return
+	}
+
+	r.CheckPlanCapabilities(ctx, req, resp, []string{sshZeroAddressConfigPath(mount.ValueString())})
+}
+
+func (r *SSHSecretBackendZeroAddressResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SSHSecretBackendZeroAddressModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	if err := r.write(ctx, c, &data); err != nil {
+		resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSHSecretBackendZeroAddressResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SSHSecretBackendZeroAddressModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	roles, err := readSSHZeroAddressRoles(ctx, c, data.Mount.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+		return
+	}
+	if roles == nil {
+		tflog.Warn(ctx, "SSH zero address roles not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	rolesValue, diags := types.SetValueFrom(ctx, types.StringType, roles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Roles = rolesValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSHSecretBackendZeroAddressResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SSHSecretBackendZeroAddressModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	if err := r.write(ctx, c, &data); err != nil {
+		resp.Diagnostics.AddError(errutil.VaultUpdateErr(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSHSecretBackendZeroAddressResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SSHSecretBackendZeroAddressModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	if _, err := c.Logical().DeleteWithContext(ctx, sshZeroAddressConfigPath(data.Mount.ValueString())); err != nil {
+		resp.Diagnostics.AddError(errutil.VaultDeleteErr(err))
+	}
+}
+
+// ImportState imports the resource from the mount path, with or without the
+// config/zeroaddress suffix.
+func (r *SSHSecretBackendZeroAddressResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	mount := strings.TrimSuffix(strings.Trim(req.ID, "/"), "/"+sshZeroAddressPath)
+	if mount == "" {
+		resp.Diagnostics.AddError(
+			"Error parsing import identifier",
+			fmt.Sprintf("The import identifier %q must be of the form '<mount>/%s', "+
+				"namespace can be specified using the env var %s", req.ID, sshZeroAddressPath, consts.EnvVarVaultNamespaceImport),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldMount), mount)...)
+
+	if ns := os.Getenv(consts.EnvVarVaultNamespaceImport); ns != "" {
+		tflog.Info(
+			ctx,
+			fmt.Sprintf("Environment variable %s set, attempting TF state import", consts.EnvVarVaultNamespaceImport),
+			map[string]any{consts.FieldNamespace: ns},
+		)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.FieldNamespace), ns)...)
+	}
+}
+
+func (r *SSHSecretBackendZeroAddressResource) write(ctx context.Context, c *api.Client, data *SSHSecretBackendZeroAddressModel) error {
+	var roles []string
+	if diags := data.Roles.ElementsAs(ctx, &roles, false); diags.HasError() {
+		return fmt.Errorf("unable to read roles from the plan")
+	}
+	sort.Strings(roles)
+
+	p := sshZeroAddressConfigPath(data.Mount.ValueString())
+	tflog.Debug(ctx, fmt.Sprintf("Writing SSH zero address roles to %q", p))
+	_, err := c.Logical().WriteWithContext(ctx, p, map[string]interface{}{
+		consts.FieldRoles: roles,
+	})
+	return err
+}
+
+// readSSHZeroAddressRoles returns the zero address roles of the mount, or nil
+// if they are not configured.
+func readSSHZeroAddressRoles(ctx context.Context, c *api.Client, mount string) ([]string, error) {
+	resp, err := c.Logical().ReadWithContext(ctx, sshZeroAddressConfigPath(mount))
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, nil
+	}
+
+	var apiModel SSHSecretBackendZeroAddressAPIModel
+	if err := model.ToAPIModel(resp.Data, &apiModel); err != nil {
+		return nil, fmt.Errorf("unable to translate Vault response data: %w", err)
+	}
+	if apiModel.Roles == nil {
+		apiModel.Roles = []string{}
+	}
+
+	return apiModel.Roles, nil
+}
+
+func sshZeroAddressConfigPath(mount string) string {
+	return fmt.Sprintf("%s/%s", strings.Trim(mount, "/"), sshZeroAddressPath)
+}
@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssh_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccSSHSecretBackendZeroAddress(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-ssh")
+	resourceName := "vault_ssh_secret_backend_zeroaddress.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSHSecretBackendZeroAddressConfig(mount, `[vault_ssh_secret_backend_role.otp.name]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMount, mount),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRoles+".#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, consts.FieldRoles+".*", "otp"),
+				),
+			},
+			{
+				Config: testAccSSHSecretBackendZeroAddressConfig(mount,
+					`[vault_ssh_secret_backend_role.otp.name, vault_ssh_secret_backend_role.otp2.name]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldRoles+".#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, consts.FieldRoles+".*", "otp"),
+					resource.TestCheckTypeSetElemAttr(resourceName, consts.FieldRoles+".*", "otp2"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateId:                        mount + "/config/zeroaddress",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: consts.FieldMount,
+			},
+		},
+	})
+}
+
+func testAccSSHSecretBackendZeroAddressConfig(mount, roles string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "ssh"
+}
+
+resource "vault_ssh_secret_backend_role" "otp" {
+  backend      = vault_mount.test.path
+  name         = "otp"
+  key_type     = "otp"
+  default_user = "ubuntu"
+}
+
+resource "vault_ssh_secret_backend_role" "otp2" {
+  backend      = vault_mount.test.path
+  name         = "otp2"
+  key_type     = "otp"
+  default_user = "admin"
+}
+
+resource "vault_ssh_secret_backend_zeroaddress" "test" {
+  mount = vault_mount.test.path
+  roles = %s
+}
+`, mount, roles)
+}
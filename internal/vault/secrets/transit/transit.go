@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+// batchInputAttribute returns the schema of the batch_input attribute. Each
+// element is a map of the request parameters of a single batch item, exactly
+// as expected by the Vault API.
+func batchInputAttribute(description string) schema.ListAttribute {
+	return schema.ListAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Sensitive:           true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+	}
+}
+
+// batchResultsAttribute returns the schema of the batch_results attribute.
+func batchResultsAttribute(description string) schema.ListAttribute {
+	return schema.ListAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		Sensitive:           true,
+		ElementType:         types.MapType{ElemType: types.StringType},
+	}
+}
+
+// getBatchInput returns the batch input in the form expected by Vault, the
+// intFields of each item are converted from strings to integers.
+func getBatchInput(ctx context.Context, v types.List, intFields []string) ([]map[string]interface{}, diag.Diagnostics) {
+	var items []map[string]string
+	if diags := v.ElementsAs(ctx, &items, false); diags.HasError() {
+		return nil, diags
+	}
+
+	input := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		m := make(map[string]interface{}, len(item))
+		for k, v := range item {
+			m[k] = v
+		}
+		input = append(input, m)
+	}
+
+	var diags diag.Diagnostics
+	batchInput, err := util.ConvertBatchInput(input, intFields)
+	if err != nil {
+		diags.AddError("Invalid batch input", err.Error())
+	}
+
+	return batchInput, diags
+}
+
+// getBatchResults returns the batch_results of a Vault response as a list of
+// maps of strings. Items that failed carry an "error" key.
+func getBatchResults(ctx context.Context, raw interface{}) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.MapType{ElemType: types.StringType}
+
+	results, err := util.ConvertBatchResults(raw)
+	if err != nil {
+		diags.AddError("Unexpected API response", err.Error())
+		return types.ListNull(elemType), diags
+	}
+
+	items := make([]map[string]string, 0, len(results))
+	for _, r := range results {
+		item := make(map[string]string, len(r))
+		for k, v := range r {
+			item[k] = fmt.Sprintf("%v", v)
+		}
+		items = append(items, item)
+	}
+
+	l, d := types.ListValueFrom(ctx, elemType, items)
+	diags.Append(d...)
+
+	return l, diags
+}
+
+// encodeBase64 returns the base64 encoding of a configured string, null values
+// are left out of the request.
+func encodeBase64(v types.String) (string, bool) {
+	if v.IsNull() || v.IsUnknown() {
+		return "", false
+	}
+
+	return base64.StdEncoding.EncodeToString([]byte(v.ValueString())), true
+}
+
+// keyVersionValue returns the key_version of a Vault response.
+func keyVersionValue(data map[string]interface{}) types.String {
+	if v, ok := data["key_version"]; ok && v != nil {
+		return types.StringValue(fmt.Sprintf("%v", v))
+	}
+
+	return types.StringNull()
+}
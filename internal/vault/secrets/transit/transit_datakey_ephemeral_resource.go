@@ -0,0 +1,244 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+const (
+	dataKeyTypePlaintext = "plaintext"
+	dataKeyTypeWrapped   = "wrapped"
+)
+
+// Ensure the implementation satisfies the ephemeral.EphemeralResource interface
+var _ ephemeral.EphemeralResource = &TransitDataKeyEphemeralResource{}
+
+// NewTransitDataKeyEphemeralResource returns the implementation for this resource
+var NewTransitDataKeyEphemeralResource = func() ephemeral.EphemeralResource {
+	return &TransitDataKeyEphemeralResource{}
+}
+
+// TransitDataKeyEphemeralResource implements the ephemeral resource
+type TransitDataKeyEphemeralResource struct {
+	base.EphemeralResourceWithConfigure
+}
+
+// TransitDataKeyModel describes the Terraform resource data model
+type TransitDataKeyModel struct {
+	base.BaseModelEphemeral
+
+	Mount      types.String `tfsdk:"mount"`
+	KeyName    types.String `tfsdk:"key_name"`
+	Type       types.String `tfsdk:"type"`
+	Context    types.String `tfsdk:"context"`
+	Bits       types.Int64  `tfsdk:"bits"`
+	BatchInput types.List   `tfsdk:"batch_input"`
+
+	// Computed
+	Plaintext          types.String `tfsdk:"plaintext"`
+	Ciphertext         types.String `tfsdk:"ciphertext"`
+	KeyVersionReturned types.String `tfsdk:"key_version_returned"`
+	BatchResults       types.List   `tfsdk:"batch_results"`
+}
+
+func (r *TransitDataKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the Transit secrets engine is mounted.",
+				Required:            true,
+			},
+			consts.FieldKeyName: schema.StringAttribute{
+				MarkdownDescription: "Name of the key used to encrypt the data key.",
+				Required:            true,
+			},
+			consts.FieldType: schema.StringAttribute{
+				MarkdownDescription: "Type of data key to generate. With `plaintext` the plaintext data key " +
+					"is returned along with the ciphertext, with `wrapped` only the ciphertext is returned.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(dataKeyTypePlaintext, dataKeyTypeWrapped),
+				},
+			},
+			consts.FieldContext: schema.StringAttribute{
+				MarkdownDescription: "Context for key derivation, it is base64-encoded by the provider.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot(consts.FieldBatchInput)),
+				},
+			},
+			consts.FieldBits: schema.Int64Attribute{
+				MarkdownDescription: "Number of bits of the data key, one of `128`, `256` or `512`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(128, 256, 512),
+					int64validator.ConflictsWith(path.MatchRoot(consts.FieldBatchInput)),
+				},
+			},
+			consts.FieldBatchInput: batchInputAttribute(
+				"List of data keys to generate. Each item is a map that accepts the `context`, " +
+					"`nonce` and `bits` parameters of the Vault API, `context` must be base64-encoded. " +
+					"Vault does not support batch data key generation, a request is made for each item.",
+			),
+			consts.FieldPlaintext: schema.StringAttribute{
+				MarkdownDescription: "Base64-encoded plaintext data key, only set when `type` is `plaintext`.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			consts.FieldCiphertext: schema.StringAttribute{
+				MarkdownDescription: "Data key encrypted with the named key.",
+				Computed:            true,
+			},
+			consts.FieldKeyVersionReturned: schema.StringAttribute{
+				MarkdownDescription: "Version of the key used to encrypt the data key, as returned by Vault.",
+				Computed:            true,
+			},
+			consts.FieldBatchResults: batchResultsAttribute(
+				"The data keys generated for `batch_input`, in the same order. Each result has the " +
+					"`ciphertext`, `key_version` and, when `type` is `plaintext`, the `plaintext` of the data key.",
+			),
+		},
+		MarkdownDescription: "Generates a data key for envelope encryption using the Transit secrets engine, " +
+			"without storing the data key in state.",
+	}
+	base.MustAddBaseEphemeralSchema(&resp.Schema)
+}
+
+func (r *TransitDataKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transit_datakey"
+}
+
+func (r *TransitDataKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data TransitDataKeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	path := fmt.Sprintf("%s/datakey/%s/%s", data.Mount.ValueString(), data.Type.ValueString(), data.KeyName.ValueString())
+
+	tflog.Debug(ctx, "Generating data key with Transit", map[string]interface{}{
+		"path": path,
+	})
+
+	data.Plaintext = types.StringNull()
+	data.Ciphertext = types.StringNull()
+	data.KeyVersionReturned = types.StringNull()
+	data.BatchResults = types.ListNull(types.MapType{ElemType: types.StringType})
+
+	if !data.BatchInput.IsNull() {
+		if data.IsWrapped() {
+			resp.Diagnostics.AddError(
+				"Invalid configuration",
+				fmt.Sprintf("%q is not supported with %q, a request is made for each item", consts.FieldWrapTTL, consts.FieldBatchInput),
+			)
+			return
+		}
+
+		batchInput, diags := getBatchInput(ctx, data.BatchInput, []string{consts.FieldBits})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		results := make([]interface{}, 0, len(batchInput))
+		for i, item := range batchInput {
+			secret, err := generateDataKey(ctx, c, path, item)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error generating data key with Vault",
+					fmt.Sprintf("Error generating data key %d at path %q: %s", i, path, err),
+				)
+				return
+			}
+			results = append(results, secret.Data)
+		}
+
+		data.BatchResults, diags = getBatchResults(ctx, results)
+		resp.Diagnostics.Append(diags...)
+	} else {
+		requestData := map[string]interface{}{}
+		if v, ok := encodeBase64(data.Context); ok {
+			requestData[consts.FieldContext] = v
+		}
+
+		if !data.Bits.IsNull() {
+			requestData[consts.FieldBits] = data.Bits.ValueInt64()
+		}
+
+		wc, err := data.WrappingClient(c)
+		if err != nil {
+			resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+			return
+		}
+
+		secret, err := generateDataKey(ctx, wc, path, requestData)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error generating data key with Vault",
+				fmt.Sprintf("Error generating data key at path %q: %s", path, err),
+			)
+			return
+		}
+
+		if data.SetWrapInfo(secret) {
+			resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+			return
+		}
+
+		ciphertext, ok := secret.Data[consts.FieldCiphertext].(string)
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Unexpected API response",
+				fmt.Sprintf("expected string for %q, got %T", consts.FieldCiphertext, secret.Data[consts.FieldCiphertext]),
+			)
+			return
+		}
+		data.Ciphertext = types.StringValue(ciphertext)
+		data.KeyVersionReturned = keyVersionValue(secret.Data)
+
+		if v, ok := secret.Data[consts.FieldPlaintext].(string); ok {
+			data.Plaintext = types.StringValue(v)
+		}
+	}
+	tflog.Debug(ctx, "Successfully generated data key with Transit", map[string]any{"path": path})
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func generateDataKey(ctx context.Context, c *api.Client, path string, requestData map[string]interface{}) (*api.Secret, error) {
+	secret, err := c.Logical().WriteWithContext(ctx, path, requestData)
+	if err != nil {
+		return nil, err
+	}
+
+	if secret == nil {
+		return nil, fmt.Errorf("no response from data key endpoint")
+	}
+
+	return secret, nil
+}
@@ -0,0 +1,195 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+// Ensure the implementation satisfies the ephemeral.EphemeralResource interface
+var _ ephemeral.EphemeralResource = &TransitDecryptEphemeralResource{}
+
+// NewTransitDecryptEphemeralResource returns the implementation for this resource
+var NewTransitDecryptEphemeralResource = func() ephemeral.EphemeralResource {
+	return &TransitDecryptEphemeralResource{}
+}
+
+// TransitDecryptEphemeralResource implements the ephemeral resource
+type TransitDecryptEphemeralResource struct {
+	base.EphemeralResourceWithConfigure
+}
+
+// TransitDecryptModel describes the Terraform resource data model
+type TransitDecryptModel struct {
+	base.BaseModelEphemeral
+
+	Mount      types.String `tfsdk:"mount"`
+	KeyName    types.String `tfsdk:"key_name"`
+	Ciphertext types.String `tfsdk:"ciphertext"`
+	Context    types.String `tfsdk:"context"`
+	BatchInput types.List   `tfsdk:"batch_input"`
+
+	// Computed
+	Plaintext    types.String `tfsdk:"plaintext"`
+	BatchResults types.List   `tfsdk:"batch_results"`
+}
+
+func (r *TransitDecryptEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the Transit secrets engine is mounted.",
+				Required:            true,
+			},
+			consts.FieldKeyName: schema.StringAttribute{
+				MarkdownDescription: "Name of the decryption key to use.",
+				Required:            true,
+			},
+			consts.FieldCiphertext: schema.StringAttribute{
+				MarkdownDescription: "Transit encrypted ciphertext to decrypt.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot(consts.FieldBatchInput)),
+				},
+			},
+			consts.FieldContext: schema.StringAttribute{
+				MarkdownDescription: "Context for key derivation, it is base64-encoded by the provider.",
+				Optional:            true,
+			},
+			consts.FieldBatchInput: batchInputAttribute(
+				"List of items to decrypt in a single request. Each item is a map that accepts the " +
+					"`ciphertext`, `context` and `nonce` parameters of the Vault API, `context` must be " +
+					"base64-encoded.",
+			),
+			consts.FieldPlaintext: schema.StringAttribute{
+				MarkdownDescription: "Decrypted plaintext, it is base64-decoded by the provider.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			consts.FieldBatchResults: batchResultsAttribute(
+				"The results returned from Vault when using `batch_input`, in the same order. The " +
+					"`plaintext` of each result is base64-encoded.",
+			),
+		},
+		MarkdownDescription: "Decrypts ciphertext using the Transit secrets engine, without storing the plaintext in state.",
+	}
+	base.MustAddBaseEphemeralSchema(&resp.Schema)
+}
+
+func (r *TransitDecryptEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transit_decrypt"
+}
+
+func (r *TransitDecryptEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data TransitDecryptModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	path := fmt.Sprintf("%s/decrypt/%s", data.Mount.ValueString(), data.KeyName.ValueString())
+
+	tflog.Debug(ctx, "Decrypting with Transit", map[string]interface{}{
+		"path": path,
+	})
+
+	requestData := map[string]interface{}{}
+	if !data.BatchInput.IsNull() {
+		batchInput, diags := getBatchInput(ctx, data.BatchInput, nil)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		requestData[consts.FieldBatchInput] = batchInput
+	}
+
+	if !data.Ciphertext.IsNull() {
+		requestData[consts.FieldCiphertext] = data.Ciphertext.ValueString()
+	}
+
+	if v, ok := encodeBase64(data.Context); ok {
+		requestData[consts.FieldContext] = v
+	}
+
+	secret, err := c.Logical().WriteWithContext(ctx, path, requestData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error decrypting with Vault",
+			fmt.Sprintf("Error decrypting with Transit at path %q: %s", path, err),
+		)
+		return
+	}
+
+	if data.SetWrapInfo(secret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
+	if secret == nil {
+		resp.Diagnostics.AddError(
+			"No response from decryption endpoint",
+			fmt.Sprintf("No response from decryption endpoint at path %q", path),
+		)
+		return
+	}
+
+	data.Plaintext = types.StringNull()
+	data.BatchResults = types.ListNull(types.MapType{ElemType: types.StringType})
+
+	if raw, ok := secret.Data[consts.FieldBatchResults]; ok {
+		var diags diag.Diagnostics
+		data.BatchResults, diags = getBatchResults(ctx, raw)
+		resp.Diagnostics.Append(diags...)
+	} else {
+		encoded, ok := secret.Data[consts.FieldPlaintext].(string)
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Unexpected API response",
+				fmt.Sprintf("expected string for %q, got %T", consts.FieldPlaintext, secret.Data[consts.FieldPlaintext]),
+			)
+			return
+		}
+
+		plaintext, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected API response",
+				fmt.Sprintf("error decoding %q: %s", consts.FieldPlaintext, err),
+			)
+			return
+		}
+		data.Plaintext = types.StringValue(string(plaintext))
+	}
+	tflog.Debug(ctx, "Successfully decrypted with Transit", map[string]any{"path": path})
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
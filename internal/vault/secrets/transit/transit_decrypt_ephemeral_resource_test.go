@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccTransitDecrypt(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-transit")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitDecryptConfig(backend, `ciphertext = ephemeral.vault_transit_encrypt.test.ciphertext
+  context    = "ctx"`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("plaintext"), knownvalue.StringExact("foo")),
+				},
+			},
+			{
+				Config: testAccTransitDecryptConfig(backend, `batch_input = [
+    { ciphertext = ephemeral.vault_transit_encrypt.test.ciphertext, context = base64encode("ctx") },
+  ]`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("plaintext"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("batch_results").AtSliceIndex(0).AtMapKey("plaintext"), knownvalue.StringExact("Zm9v")),
+				},
+			},
+		},
+	})
+}
+
+func testAccTransitDecryptConfig(backend, args string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend          = vault_mount.test.path
+  name             = "test"
+  derived          = true
+  deletion_allowed = true
+}
+
+ephemeral "vault_transit_encrypt" "test" {
+  mount     = vault_transit_secret_backend_key.test.backend
+  key_name  = vault_transit_secret_backend_key.test.name
+  plaintext = "foo"
+  context   = "ctx"
+}
+
+ephemeral "vault_transit_decrypt" "test" {
+  mount    = vault_transit_secret_backend_key.test.backend
+  key_name = vault_transit_secret_backend_key.test.name
+  %s
+}
+
+provider "echo" {
+  data = ephemeral.vault_transit_decrypt.test
+}
+
+resource "echo" "test" {}
+`, backend, args)
+}
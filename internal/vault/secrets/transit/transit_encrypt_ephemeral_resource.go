@@ -0,0 +1,200 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+// Ensure the implementation satisfies the ephemeral.EphemeralResource interface
+var _ ephemeral.EphemeralResource = &TransitEncryptEphemeralResource{}
+
+// NewTransitEncryptEphemeralResource returns the implementation for this resource
+var NewTransitEncryptEphemeralResource = func() ephemeral.EphemeralResource {
+	return &TransitEncryptEphemeralResource{}
+}
+
+// TransitEncryptEphemeralResource implements the ephemeral resource
+type TransitEncryptEphemeralResource struct {
+	base.EphemeralResourceWithConfigure
+}
+
+// TransitEncryptModel describes the Terraform resource data model
+type TransitEncryptModel struct {
+	base.BaseModelEphemeral
+
+	Mount      types.String `tfsdk:"mount"`
+	KeyName    types.String `tfsdk:"key_name"`
+	Plaintext  types.String `tfsdk:"plaintext"`
+	Context    types.String `tfsdk:"context"`
+	KeyVersion types.Int64  `tfsdk:"key_version"`
+	BatchInput types.List   `tfsdk:"batch_input"`
+
+	// Computed
+	Ciphertext         types.String `tfsdk:"ciphertext"`
+	KeyVersionReturned types.String `tfsdk:"key_version_returned"`
+	BatchResults       types.List   `tfsdk:"batch_results"`
+}
+
+func (r *TransitEncryptEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the Transit secrets engine is mounted.",
+				Required:            true,
+			},
+			consts.FieldKeyName: schema.StringAttribute{
+				MarkdownDescription: "Name of the encryption key to use.",
+				Required:            true,
+			},
+			consts.FieldPlaintext: schema.StringAttribute{
+				MarkdownDescription: "Plaintext to encrypt, it is base64-encoded by the provider.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot(consts.FieldBatchInput)),
+				},
+			},
+			consts.FieldContext: schema.StringAttribute{
+				MarkdownDescription: "Context for key derivation, it is base64-encoded by the provider.",
+				Optional:            true,
+			},
+			consts.FieldKeyVersion: schema.Int64Attribute{
+				MarkdownDescription: "Version of the key to use for encryption.",
+				Optional:            true,
+			},
+			consts.FieldBatchInput: batchInputAttribute(
+				"List of items to encrypt in a single request. Each item is a map that accepts the " +
+					"`plaintext`, `context`, `nonce` and `key_version` parameters of the Vault API, " +
+					"`plaintext` and `context` must be base64-encoded.",
+			),
+			consts.FieldCiphertext: schema.StringAttribute{
+				MarkdownDescription: "Transit encrypted ciphertext.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			consts.FieldKeyVersionReturned: schema.StringAttribute{
+				MarkdownDescription: "Version of the key used for encryption, as returned by Vault.",
+				Computed:            true,
+			},
+			consts.FieldBatchResults: batchResultsAttribute(
+				"The results returned from Vault when using `batch_input`, in the same order.",
+			),
+		},
+		MarkdownDescription: "Encrypts plaintext using the Transit secrets engine, without storing the plaintext in state.",
+	}
+	base.MustAddBaseEphemeralSchema(&resp.Schema)
+}
+
+func (r *TransitEncryptEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transit_encrypt"
+}
+
+func (r *TransitEncryptEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data TransitEncryptModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	path := fmt.Sprintf("%s/encrypt/%s", data.Mount.ValueString(), data.KeyName.ValueString())
+
+	tflog.Debug(ctx, "Encrypting with Transit", map[string]interface{}{
+		"path": path,
+	})
+
+	requestData := map[string]interface{}{}
+	if !data.BatchInput.IsNull() {
+		batchInput, diags := getBatchInput(ctx, data.BatchInput, []string{consts.FieldKeyVersion})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		requestData[consts.FieldBatchInput] = batchInput
+	}
+
+	if v, ok := encodeBase64(data.Plaintext); ok {
+		requestData[consts.FieldPlaintext] = v
+	}
+
+	if v, ok := encodeBase64(data.Context); ok {
+		requestData[consts.FieldContext] = v
+	}
+
+	if !data.KeyVersion.IsNull() {
+		requestData[consts.FieldKeyVersion] = data.KeyVersion.ValueInt64()
+	}
+
+	secret, err := c.Logical().WriteWithContext(ctx, path, requestData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error encrypting with Vault",
+			fmt.Sprintf("Error encrypting with Transit at path %q: %s", path, err),
+		)
+		return
+	}
+
+	if data.SetWrapInfo(secret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
+	if secret == nil {
+		resp.Diagnostics.AddError(
+			"No response from encryption endpoint",
+			fmt.Sprintf("No response from encryption endpoint at path %q", path),
+		)
+		return
+	}
+
+	data.Ciphertext = types.StringNull()
+	data.KeyVersionReturned = keyVersionValue(secret.Data)
+	data.BatchResults = types.ListNull(types.MapType{ElemType: types.StringType})
+
+	if raw, ok := secret.Data[consts.FieldBatchResults]; ok {
+		var diags diag.Diagnostics
+		data.BatchResults, diags = getBatchResults(ctx, raw)
+		resp.Diagnostics.Append(diags...)
+	} else {
+		ciphertext, ok := secret.Data[consts.FieldCiphertext].(string)
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Unexpected API response",
+				fmt.Sprintf("expected string for %q, got %T", consts.FieldCiphertext, secret.Data[consts.FieldCiphertext]),
+			)
+			return
+		}
+		data.Ciphertext = types.StringValue(ciphertext)
+	}
+	tflog.Debug(ctx, "Successfully encrypted with Transit", map[string]any{"path": path})
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
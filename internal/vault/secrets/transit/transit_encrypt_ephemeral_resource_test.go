@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+var regexpTransitCiphertext = regexp.MustCompile(`^vault:v1:.+$`)
+
+func TestAccTransitEncrypt(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-transit")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitEncryptConfig(backend, `plaintext = "foo"`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("ciphertext"), knownvalue.StringRegexp(regexpTransitCiphertext)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("key_version_returned"), knownvalue.StringExact("1")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("batch_results"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: testAccTransitEncryptConfig(backend, `batch_input = [
+    { plaintext = base64encode("foo") },
+    { plaintext = base64encode("bar"), key_version = "1" },
+  ]`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("ciphertext"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("batch_results"), knownvalue.ListSizeExact(2)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("batch_results").AtSliceIndex(1).AtMapKey("ciphertext"), knownvalue.StringRegexp(regexpTransitCiphertext)),
+				},
+			},
+			{
+				Config:      testAccTransitEncryptConfig(backend, ""),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccTransitEncryptConfig(backend, args string) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "vault_transit_encrypt" "test" {
+  mount    = vault_transit_secret_backend_key.test.backend
+  key_name = vault_transit_secret_backend_key.test.name
+  %s
+}
+
+provider "echo" {
+  data = ephemeral.vault_transit_encrypt.test
+}
+
+resource "echo" "test" {}
+`, testAccTransitKeyConfig(backend), args)
+}
+
+func testAccTransitKeyConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend          = vault_mount.test.path
+  name             = "test"
+  deletion_allowed = true
+}
+`, backend)
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+var _ action.ActionWithConfigure = &TransitKeyRotateAction{}
+
+// NewTransitKeyRotateAction returns the implementation for this action
+var NewTransitKeyRotateAction = func() action.Action {
+	return &TransitKeyRotateAction{}
+}
+
+// TransitKeyRotateAction rotates a Transit key to a new version.
+type TransitKeyRotateAction struct {
+	base.ActionWithConfigure
+}
+
+// TransitKeyRotateModel describes the Terraform action data model
+type TransitKeyRotateModel struct {
+	base.BaseActionModel
+
+	Mount types.String `tfsdk:"mount"`
+	Name  types.String `tfsdk:"name"`
+}
+
+func (a *TransitKeyRotateAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transit_secret_backend_key_rotate"
+}
+
+func (a *TransitKeyRotateAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Rotates a Transit key to a new version.",
+		Attributes: map[string]schema.Attribute{
+			consts.FieldMount: schema.StringAttribute{
+				MarkdownDescription: "Path where the Transit secrets engine is mounted.",
+				Required:            true,
+			},
+			consts.FieldName: schema.StringAttribute{
+				MarkdownDescription: "Name of the key to rotate.",
+				Required:            true,
+			},
+		},
+	}
+	base.MustAddBaseActionSchema(&resp.Schema)
+}
+
+func (a *TransitKeyRotateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data TransitKeyRotateModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, a.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	path := fmt.Sprintf("%s/keys/%s/rotate", data.Mount.ValueString(), data.Name.ValueString())
+	base.SendProgress(resp, "Rotating Transit key %q", data.Name.ValueString())
+
+	secret, err := c.Logical().WriteWithContext(ctx, path, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error rotating Transit key",
+			fmt.Sprintf("Error rotating Transit key at path %q: %s", path, err),
+		)
+		return
+	}
+
+	if secret != nil {
+		if v, ok := secret.Data[consts.FieldLatestVersion]; ok {
+			base.SendProgress(resp, "Rotated Transit key %q to version %v", data.Name.ValueString(), v)
+			return
+		}
+	}
+
+	base.SendProgress(resp, "Rotated Transit key %q", data.Name.ValueString())
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccTransitKeyRotateAction(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-transit")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitKeyRotateActionConfig(backend),
+				Check:  testAccTransitCheckLatestVersion(backend, "test", 2),
+			},
+		},
+	})
+}
+
+func testAccTransitCheckLatestVersion(backend, name string, expected int64) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client, err := api.NewClient(api.DefaultConfig())
+		if err != nil {
+			return err
+		}
+
+		path := fmt.Sprintf("%s/keys/%s", backend, name)
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return err
+		}
+		if resp == nil {
+			return fmt.Errorf("transit key %q not found", path)
+		}
+
+		v, ok := resp.Data[consts.FieldLatestVersion].(json.Number)
+		if !ok {
+			return fmt.Errorf("unexpected %s %#v", consts.FieldLatestVersion, resp.Data[consts.FieldLatestVersion])
+		}
+		latest, err := v.Int64()
+		if err != nil {
+			return err
+		}
+		if latest != expected {
+			return fmt.Errorf("expected %s %d, got %d", consts.FieldLatestVersion, expected, latest)
+		}
+
+		return nil
+	}
+}
+
+func testAccTransitKeyRotateActionConfig(backend string) string {
+	return fmt.Sprintf(`
+%s
+
+action "vault_transit_secret_backend_key_rotate" "test" {
+  config {
+    mount = vault_transit_secret_backend_key.test.backend
+    name  = vault_transit_secret_backend_key.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = vault_transit_secret_backend_key.test.name
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.vault_transit_secret_backend_key_rotate.test]
+    }
+  }
+}
+`, testAccTransitKeyConfig(backend))
+}
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package transit_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccTransitRewrap(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-transit")
+	v2 := regexp.MustCompile(`^vault:v2:.+$`)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitRewrapConfig(backend, `ciphertext = ephemeral.vault_transit_encrypt.test.ciphertext`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("new_ciphertext"), knownvalue.StringRegexp(v2)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("key_version_returned"), knownvalue.StringExact("2")),
+				},
+			},
+			{
+				Config: testAccTransitRewrapConfig(backend, `batch_input = [
+    { ciphertext = ephemeral.vault_transit_encrypt.test.ciphertext },
+    { ciphertext = ephemeral.vault_transit_encrypt.test.ciphertext, key_version = "2" },
+  ]`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("batch_results"), knownvalue.ListSizeExact(2)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("batch_results").AtSliceIndex(0).AtMapKey("ciphertext"), knownvalue.StringRegexp(v2)),
+				},
+			},
+		},
+	})
+}
+
+func testAccTransitRewrapConfig(backend, args string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend          = vault_mount.test.path
+  name             = "test"
+  deletion_allowed = true
+}
+
+ephemeral "vault_transit_encrypt" "test" {
+  mount       = vault_transit_secret_backend_key.test.backend
+  key_name    = vault_transit_secret_backend_key.test.name
+  plaintext   = "foo"
+  key_version = 1
+}
+
+resource "vault_generic_endpoint" "rotate" {
+  path                 = "${vault_transit_secret_backend_key.test.backend}/keys/${vault_transit_secret_backend_key.test.name}/rotate"
+  disable_read         = true
+  disable_delete       = true
+  ignore_absent_fields = true
+  data_json            = "{}"
+}
+
+ephemeral "vault_transit_rewrap" "test" {
+  mount    = vault_transit_secret_backend_key.test.backend
+  key_name = vault_transit_secret_backend_key.test.name
+  %s
+
+  depends_on = [vault_generic_endpoint.rotate]
+}
+
+provider "echo" {
+  data = ephemeral.vault_transit_rewrap.test
+}
+
+resource "echo" "test" {}
+`, backend, args)
+}
@@ -0,0 +1,168 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/model"
+)
+
+const auditDevicesPath = "sys/audit"
+
+var _ datasource.DataSourceWithConfigure = &AuditDevicesDataSource{}
+
+// NewAuditDevicesDataSource returns the implementation for this data source
+func NewAuditDevicesDataSource() datasource.DataSource {
+	return &AuditDevicesDataSource{}
+}
+
+// AuditDevicesDataSource implements the methods that define this data source
+type AuditDevicesDataSource struct {
+	base.DataSourceWithConfigure
+}
+
+// AuditDevicesModel describes the Terraform data source data model
+type AuditDevicesModel struct {
+	base.BaseModel
+
+	Devices []AuditDeviceModel `tfsdk:"devices"`
+}
+
+// AuditDeviceModel describes an enabled audit device.
+type AuditDeviceModel struct {
+	Path        types.String `tfsdk:"path"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+	Local       types.Bool   `tfsdk:"local"`
+	Options     types.Map    `tfsdk:"options"`
+}
+
+func (d *AuditDevicesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_devices"
+}
+
+func (d *AuditDevicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldNamespace: schema.StringAttribute{
+				MarkdownDescription: "Target namespace.",
+				Optional:            true,
+			},
+			"devices": schema.ListNestedAttribute{
+				MarkdownDescription: "The enabled audit devices, sorted by path.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						consts.FieldPath: schema.StringAttribute{
+							MarkdownDescription: "Path of the audit device, without a trailing slash.",
+							Computed:            true,
+						},
+						consts.FieldType: schema.StringAttribute{
+							MarkdownDescription: "Type of the audit device, e.g. `file`, `syslog` or `socket`.",
+							Computed:            true,
+						},
+						consts.FieldDescription: schema.StringAttribute{
+							MarkdownDescription: "Description of the audit device.",
+							Computed:            true,
+						},
+						consts.FieldLocal: schema.BoolAttribute{
+							MarkdownDescription: "True if the audit device is local to the cluster and not replicated.",
+							Computed:            true,
+						},
+						consts.FieldOptions: schema.MapAttribute{
+							MarkdownDescription: "Options of the audit device.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		MarkdownDescription: "Lists the enabled audit devices with their options.",
+	}
+}
+
+func (d *AuditDevicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AuditDevicesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, d.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	devices, err := listAuditDevices(ctx, c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+		return
+	}
+
+	data.Devices = make([]AuditDeviceModel, 0, len(devices))
+	for _, v := range devices {
+		options, diags := types.MapValueFrom(ctx, types.StringType, v.Options)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Devices = append(data.Devices, AuditDeviceModel{
+			Path:        types.StringValue(strings.TrimSuffix(v.Path, "/")),
+			Type:        types.StringValue(v.Type),
+			Description: types.StringValue(v.Description),
+			Local:       types.BoolValue(v.Local),
+			Options:     options,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listAuditDevices returns the enabled audit devices, sorted by path.
+func listAuditDevices(ctx context.Context, c *api.Client) ([]api.Audit, error) {
+	resp, err := c.Logical().ReadWithContext(ctx, auditDevicesPath)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, nil
+	}
+
+	var apiModel map[string]api.Audit
+	if err := model.ToAPIModel(resp.Data, &apiModel); err != nil {
+		return nil, fmt.Errorf("unable to translate the audit devices of %q: %w", auditDevicesPath, err)
+	}
+
+	devices := make([]api.Audit, 0, len(apiModel))
+	for k, v := range apiModel {
+		if v.Path == "" {
+			v.Path = k
+		}
+		if v.Options == nil {
+			v.Options = map[string]string{}
+		}
+		devices = append(devices, v)
+	}
+	sort.Slice(devices, func(i, j int) bool {
+		return devices[i].Path < devices[j].Path
+	})
+
+	return devices, nil
+}
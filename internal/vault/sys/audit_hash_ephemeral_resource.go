@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+var _ ephemeral.EphemeralResource = &AuditHashEphemeralResource{}
+
+// NewAuditHashEphemeralResource returns the implementation for this resource to be
+// imported by the Terraform Plugin Framework provider
+var NewAuditHashEphemeralResource = func() ephemeral.EphemeralResource {
+	return &AuditHashEphemeralResource{}
+}
+
+// AuditHashEphemeralResource implements the methods that define this resource
+type AuditHashEphemeralResource struct {
+	base.EphemeralResourceWithConfigure
+}
+
+// AuditHashModel describes the Terraform resource data model to match the
+// resource schema.
+type AuditHashModel struct {
+	base.BaseModelEphemeral
+
+	Path  types.String `tfsdk:"path"`
+	Input types.String `tfsdk:"input"`
+	Hash  types.String `tfsdk:"hash"`
+}
+
+func (r *AuditHashEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldPath: schema.StringAttribute{
+				MarkdownDescription: "Path of the audit device whose salt is used to hash the input.",
+				Required:            true,
+			},
+			consts.FieldInput: schema.StringAttribute{
+				MarkdownDescription: "The value to hash.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "The hash of the input, as it appears in the logs of the audit device.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: "Hashes a value with the salt of an audit device, to find it in the audit log.",
+	}
+
+	base.MustAddBaseEphemeralSchema(&resp.Schema)
+}
+
+func (r *AuditHashEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_hash"
+}
+
+func (r *AuditHashEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data AuditHashModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	secret, err := auditHash(ctx, c, data.Path.ValueString(), data.Input.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error hashing input", err.Error())
+		return
+	}
+
+	if data.SetWrapInfo(secret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
+	if secret == nil || secret.Data == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+
+	hash, ok := secret.Data["hash"].(string)
+	if !ok {
+		resp.Diagnostics.AddError("Error hashing input", "no hash returned by Vault")
+		return
+	}
+	data.Hash = types.StringValue(hash)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// auditHash hashes input with the salt of the audit device at p. The response
+// is returned as is, since it may be wrapped.
+func auditHash(ctx context.Context, c *api.Client, p, input string) (*api.Secret, error) {
+	hashPath := fmt.Sprintf("sys/audit-hash/%s", strings.Trim(p, "/"))
+	return c.Logical().WriteWithContext(ctx, hashPath, map[string]interface{}{
+		consts.FieldInput: input,
+	})
+}
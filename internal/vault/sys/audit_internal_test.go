@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestListAuditDevices(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v1/sys/audit" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"syslog/": map[string]interface{}{
+					"type":        "syslog",
+					"description": "",
+					"local":       false,
+					"path":        "syslog/",
+				},
+				"file/": map[string]interface{}{
+					"type":        "file",
+					"description": "stdout",
+					"local":       true,
+					"options": map[string]string{
+						"file_path": "stdout",
+					},
+					"path": "file/",
+				},
+			},
+		})
+	})
+
+	config, ln := testutil.TestHTTPServer(t, handler)
+	defer ln.Close()
+
+	c, err := api.NewClient(config)
+	require.NoError(t, err)
+
+	devices, err := listAuditDevices(context.Background(), c)
+	require.NoError(t, err)
+	assert.Equal(t, []api.Audit{
+		{
+			Type:        "file",
+			Description: "stdout",
+			Local:       true,
+			Options:     map[string]string{"file_path": "stdout"},
+			Path:        "file/",
+		},
+		{
+			Type:    "syslog",
+			Options: map[string]string{},
+			Path:    "syslog/",
+		},
+	}, devices)
+}
+
+func TestAuditHash(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/v1/sys/audit-hash/file" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "s3cr3t", body["input"])
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"hash": "hmac-sha256:abc",
+			},
+		})
+	})
+
+	config, ln := testutil.TestHTTPServer(t, handler)
+	defer ln.Close()
+
+	c, err := api.NewClient(config)
+	require.NoError(t, err)
+
+	secret, err := auditHash(context.Background(), c, "file/", "s3cr3t")
+	require.NoError(t, err)
+	require.NotNil(t, secret)
+	assert.Equal(t, "hmac-sha256:abc", secret.Data["hash"])
+}
@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccAuditDevicesDataSource(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-audit")
+	dataSourceName := "data.vault_audit_devices.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "vault_audit_devices" "test" {
+  depends_on = [vault_audit.test]
+}
+`, testAccAuditConfig(path)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "devices.*", map[string]string{
+						consts.FieldPath:              path,
+						consts.FieldType:              "file",
+						consts.FieldDescription:       "Audit device for hash tests",
+						consts.FieldLocal:             "true",
+						consts.FieldOptions + ".path": "stdout",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuditHashEphemeralResource(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-audit")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+ephemeral "vault_audit_hash" "test" {
+  path  = vault_audit.test.path
+  input = "s3cr3t"
+}
+
+provider "echo" {
+  data = ephemeral.vault_audit_hash.test.hash
+}
+
+resource "echo" "test" {}
+`, testAccAuditConfig(path)),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^hmac-sha256:[0-9a-f]{64}$`))),
+				},
+			},
+		},
+	})
+}
+
+func testAccAuditConfig(path string) string {
+	return fmt.Sprintf(`
+resource "vault_audit" "test" {
+  path        = "%s"
+  type        = "file"
+  description = "Audit device for hash tests"
+  local       = true
+  options = {
+    path = "stdout"
+  }
+}
+`, path)
+}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+var _ list.ListResourceWithConfigure = &AuthBackendListResource{}
+var _ list.ListResourceWithRawV5Schemas = &AuthBackendListResource{}
+
+// NewAuthBackendListResource returns the implementation for this list resource to
+// be used by the Terraform Plugin Framework provider.
+func NewAuthBackendListResource() list.ListResource {
+	return &AuthBackendListResource{}
+}
+
+// AuthBackendListResource lists the auth methods of a namespace as vault_auth_backend
+// resources.
+type AuthBackendListResource struct {
+	base.SDKv2ListResource
+}
+
+// AuthBackendListModel describes the list resource configuration.
+type AuthBackendListModel struct {
+	base.BaseListModel
+
+	Type types.String `tfsdk:"type"`
+}
+
+// Metadata defines the list resource name, it matches the listed resource.
+func (r *AuthBackendListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_backend"
+}
+
+// ListResourceConfigSchema defines the list resource configuration schema.
+func (r *AuthBackendListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the auth methods enabled in Vault. The token auth method is not listed.",
+		Attributes: map[string]schema.Attribute{
+			consts.FieldType: schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list auth methods of this type, e.g. `userpass`.",
+			},
+		},
+	}
+
+	base.MustAddBaseListSchema(&resp.Schema)
+}
+
+// List streams the auth methods of the namespace.
+func (r *AuthBackendListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data AuthBackendListModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		stream.Results = base.ListResultsError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	auths, err := c.Sys().ListAuthWithContext(ctx)
+	if err != nil {
+		stream.Results = base.ListResultsError(errutil.VaultReadErr(err))
+		return
+	}
+
+	var objects []base.ListObject
+	for path, m := range auths {
+		if m.Type == "token" || m.Type == "ns_token" {
+			continue
+		}
+		if !data.Type.IsNull() && m.Type != data.Type.ValueString() {
+			continue
+		}
+
+		path = strings.TrimSuffix(path, "/")
+		objects = append(objects, base.ListObject{
+			ID:          path,
+			DisplayName: path + " (" + m.Type + ")",
+		})
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].ID < objects[j].ID
+	})
+
+	stream.Results = r.Results(ctx, req, data.Namespace.ValueString(), objects)
+}
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccAuthBackendListResource(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-userpass")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_auth_backend" "test" {
+  path = "%s"
+  type = "userpass"
+}
+`, path),
+			},
+			{
+				Query: true,
+				Config: `
+list "vault_auth_backend" "test" {
+  provider = vault
+
+  config {
+    type = "userpass"
+  }
+}
+
+list "vault_auth_backend" "token" {
+  provider = vault
+
+  config {
+    type = "token"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectIdentity("vault_auth_backend.test", map[string]knownvalue.Check{
+						consts.FieldID:        knownvalue.StringExact(path),
+						consts.FieldNamespace: knownvalue.Null(),
+					}),
+					querycheck.ExpectLength("vault_auth_backend.token", 0),
+				},
+			},
+		},
+	})
+}
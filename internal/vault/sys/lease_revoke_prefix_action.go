@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+var _ action.ActionWithConfigure = &LeaseRevokePrefixAction{}
+
+// leaseRevokePrefixRegexp requires at least one character other than a
+// slash, since an empty prefix revokes every lease in the namespace.
+var leaseRevokePrefixRegexp = regexp.MustCompile(`[^/]`)
+
+// NewLeaseRevokePrefixAction returns the implementation for this action
+var NewLeaseRevokePrefixAction = func() action.Action {
+	return &LeaseRevokePrefixAction{}
+}
+
+// LeaseRevokePrefixAction revokes all the leases and tokens issued under a
+// path prefix.
+type LeaseRevokePrefixAction struct {
+	base.ActionWithConfigure
+}
+
+// LeaseRevokePrefixModel describes the Terraform action data model
+type LeaseRevokePrefixModel struct {
+	base.BaseActionModel
+
+	Prefix types.String `tfsdk:"prefix"`
+	Force  types.Bool   `tfsdk:"force"`
+	Sync   types.Bool   `tfsdk:"sync"`
+}
+
+func (a *LeaseRevokePrefixAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_lease_revoke_prefix"
+}
+
+func (a *LeaseRevokePrefixAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Revokes all the secrets and tokens generated under a path prefix.",
+		Attributes: map[string]schema.Attribute{
+			consts.FieldPrefix: schema.StringAttribute{
+				MarkdownDescription: "The path prefix of the leases to revoke, e.g. `aws/creds/deploy`. " +
+					"Must not be empty or only contain slashes.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.RegexMatches(leaseRevokePrefixRegexp, "must not only contain slashes"),
+				},
+			},
+			consts.FieldForce: schema.BoolAttribute{
+				MarkdownDescription: "Delete the leases from Vault even if the secret engine revocation fails. " +
+					"Use with caution, the secrets may still be valid.",
+				Optional: true,
+			},
+			consts.FieldSync: schema.BoolAttribute{
+				MarkdownDescription: "Wait for the revocation to complete. Defaults to `true` in Vault.",
+				Optional:            true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot(consts.FieldForce)),
+				},
+			},
+		},
+	}
+	base.MustAddBaseActionSchema(&resp.Schema)
+}
+
+func (a *LeaseRevokePrefixAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data LeaseRevokePrefixModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prefix := strings.Trim(data.Prefix.ValueString(), "/")
+	reqPath, err := leaseRevokePrefixPath(prefix, data.Force.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid prefix", err.Error())
+		return
+	}
+
+	c, err := client.GetClient(ctx, a.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	var body map[string]interface{}
+	if !data.Sync.IsNull() {
+		body = map[string]interface{}{
+			consts.FieldSync: data.Sync.ValueBool(),
+		}
+	}
+
+	base.SendProgress(resp, "Revoking leases under prefix %q", prefix)
+
+	if _, err := c.Logical().WriteWithContext(ctx, reqPath, body); err != nil {
+		resp.Diagnostics.AddError(
+			"Error revoking leases",
+			fmt.Sprintf("Error revoking leases at path %q: %s", reqPath, err),
+		)
+		return
+	}
+
+	base.SendProgress(resp, "Revoked leases under prefix %q", prefix)
+}
+
+// leaseRevokePrefixPath returns the path of the revocation request. An empty
+// prefix is refused, since it would revoke every lease in the namespace.
+func leaseRevokePrefixPath(prefix string, force bool) (string, error) {
+	if prefix == "" {
+		return "", errors.New("refusing to revoke the leases under an empty prefix, " +
+			"this would revoke every lease in the namespace")
+	}
+
+	endpoint := "revoke-prefix"
+	if force {
+		endpoint = "revoke-force"
+	}
+	return fmt.Sprintf("sys/leases/%s/%s", endpoint, prefix), nil
+}
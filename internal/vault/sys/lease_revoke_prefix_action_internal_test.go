@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeaseRevokePrefixPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		prefix  string
+		force   bool
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "prefix",
+			prefix: "aws/creds/deploy",
+			want:   "sys/leases/revoke-prefix/aws/creds/deploy",
+		},
+		{
+			name:   "force",
+			prefix: "aws/creds/deploy",
+			force:  true,
+			want:   "sys/leases/revoke-force/aws/creds/deploy",
+		},
+		{
+			name:    "empty",
+			prefix:  "",
+			wantErr: true,
+		},
+		{
+			name:    "empty-force",
+			prefix:  "",
+			force:   true,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := leaseRevokePrefixPath(tt.prefix, tt.force)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLeaseRevokePrefixRegexp(t *testing.T) {
+	for _, v := range []string{"/", "//", "///"} {
+		assert.False(t, leaseRevokePrefixRegexp.MatchString(v), v)
+	}
+	for _, v := range []string{"a", "/aws/", "aws/creds/deploy"} {
+		assert.True(t, leaseRevokePrefixRegexp.MatchString(v), v)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccLeaseRevokePrefixAction(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-userpass")
+	prefix := fmt.Sprintf("auth/%s/login/u1", mount)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLeaseRevokePrefixConfig(mount, ""),
+				Check:  testAccCheckLeaseCount(prefix, 1),
+			},
+			{
+				Config: testAccLeaseRevokePrefixConfig(mount, fmt.Sprintf(`
+action "vault_lease_revoke_prefix" "test" {
+  config {
+    prefix = "%s"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = vault_generic_endpoint.login.path
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.vault_lease_revoke_prefix.test]
+    }
+  }
+}
+`, prefix)),
+				Check: testAccCheckLeaseCount(prefix, 0),
+			},
+			{
+				Config: testAccLeaseRevokePrefixConfig(mount, fmt.Sprintf(`
+action "vault_lease_revoke_prefix" "test" {
+  config {
+    prefix = "%s"
+    force  = true
+    sync   = true
+  }
+}
+`, prefix)),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccLeaseRevokePrefixConfig(mount, `
+action "vault_lease_revoke_prefix" "test" {
+  config {
+    prefix = "//"
+  }
+}
+`),
+				ExpectError: regexp.MustCompile(`must not only contain slashes`),
+			},
+		},
+	})
+}
+
+func testAccCheckLeaseCount(prefix string, expected int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		client, err := api.NewClient(api.DefaultConfig())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Logical().List("sys/leases/lookup/" + prefix)
+		if err != nil {
+			return err
+		}
+
+		var count int
+		if resp != nil {
+			if keys, ok := resp.Data["keys"].([]interface{}); ok {
+				count = len(keys)
+			}
+		}
+
+		if count != expected {
+			return fmt.Errorf("expected %d leases under %q, got %d", expected, prefix, count)
+		}
+
+		return nil
+	}
+}
+
+func testAccLeaseRevokePrefixConfig(mount, extra string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "test" {
+  type = "userpass"
+  path = "%s"
+}
+
+resource "vault_generic_endpoint" "user" {
+  path                 = "auth/${vault_auth_backend.test.path}/users/u1"
+  ignore_absent_fields = true
+
+  data_json = jsonencode({
+    password = "something"
+  })
+}
+
+resource "vault_generic_endpoint" "login" {
+  depends_on     = [vault_generic_endpoint.user]
+  path           = "auth/${vault_auth_backend.test.path}/login/u1"
+  disable_read   = true
+  disable_delete = true
+
+  data_json = jsonencode({
+    password = "something"
+  })
+}
+
+%s
+`, mount, extra)
+}
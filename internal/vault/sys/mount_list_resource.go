@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+var _ list.ListResourceWithConfigure = &MountListResource{}
+var _ list.ListResourceWithRawV5Schemas = &MountListResource{}
+
+// systemMountTypes are the mounts that exist in every namespace, they are
+// managed by Vault and can't be imported.
+var systemMountTypes = map[string]bool{
+	"system":       true,
+	"cubbyhole":    true,
+	"identity":     true,
+	"ns_system":    true,
+	"ns_cubbyhole": true,
+	"ns_identity":  true,
+}
+
+// NewMountListResource returns the implementation for this list resource to
+// be used by the Terraform Plugin Framework provider.
+func NewMountListResource() list.ListResource {
+	return &MountListResource{}
+}
+
+// MountListResource lists the secrets engines of a namespace as vault_mount
+// resources.
+type MountListResource struct {
+	base.SDKv2ListResource
+}
+
+// MountListModel describes the list resource configuration.
+type MountListModel struct {
+	base.BaseListModel
+
+	Type types.String `tfsdk:"type"`
+}
+
+// Metadata defines the list resource name, it matches the listed resource.
+func (r *MountListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mount"
+}
+
+// ListResourceConfigSchema defines the list resource configuration schema.
+func (r *MountListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the secrets engines mounted in Vault. System mounts are not listed.",
+		Attributes: map[string]schema.Attribute{
+			consts.FieldType: schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list secrets engines of this type, e.g. `kv`.",
+			},
+		},
+	}
+
+	base.MustAddBaseListSchema(&resp.Schema)
+}
+
+// List streams the mounts of the namespace.
+func (r *MountListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data MountListModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		stream.Results = base.ListResultsError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	mounts, err := c.Sys().ListMountsWithContext(ctx)
+	if err != nil {
+		stream.Results = base.ListResultsError(errutil.VaultReadErr(err))
+		return
+	}
+
+	var objects []base.ListObject
+	for path, m := range mounts {
+		if systemMountTypes[m.Type] {
+			continue
+		}
+		if !data.Type.IsNull() && m.Type != data.Type.ValueString() {
+			continue
+		}
+
+		path = strings.TrimSuffix(path, "/")
+		objects = append(objects, base.ListObject{
+			ID:          path,
+			DisplayName: path + " (" + m.Type + ")",
+		})
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].ID < objects[j].ID
+	})
+
+	stream.Results = r.Results(ctx, req, data.Namespace.ValueString(), objects)
+}
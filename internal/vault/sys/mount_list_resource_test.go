@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccMountListResource(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-kv")
+	resourceName := "vault_mount.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMountListResourceConfig(path),
+			},
+			{
+				Query:  true,
+				Config: testAccMountListResourceQueryConfig("kv"),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("vault_mount.test", 1),
+					querycheck.ExpectIdentity("vault_mount.test", map[string]knownvalue.Check{
+						consts.FieldID:        knownvalue.StringExact(path),
+						consts.FieldNamespace: knownvalue.Null(),
+					}),
+				},
+			},
+			{
+				Query:  true,
+				Config: testAccMountListResourceQueryConfig("cubbyhole"),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("vault_mount.test", 0),
+				},
+			},
+			{
+				Config:          testAccMountListResourceConfig(path),
+				ResourceName:    resourceName,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func testAccMountListResourceConfig(path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "kv"
+  options = {
+    version = "2"
+  }
+}
+`, path)
+}
+
+func testAccMountListResourceQueryConfig(mountType string) string {
+	return fmt.Sprintf(`
+list "vault_mount" "test" {
+  provider = vault
+
+  config {
+    type = "%s"
+  }
+}
+`, mountType)
+}
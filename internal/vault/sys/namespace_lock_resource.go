@@ -0,0 +1,302 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+const (
+	fieldLocked = "locked"
+
+	// apiFieldUnlockKey is the field of the unlock key in the requests and
+	// responses of the api-lock endpoints.
+	apiFieldUnlockKey = "unlock_key"
+)
+
+var (
+	_ resource.ResourceWithConfigure  = &NamespaceLockResource{}
+	_ resource.ResourceWithModifyPlan = &NamespaceLockResource{}
+)
+
+// NewNamespaceLockResource returns the implementation for this resource to be
+// imported by the Terraform Plugin Framework provider.
+func NewNamespaceLockResource() resource.Resource {
+	return &NamespaceLockResource{}
+}
+
+// NamespaceLockResource locks the API of a namespace and its descendants.
+type NamespaceLockResource struct {
+	base.ResourceWithConfigure
+}
+
+// NamespaceLockModel describes the Terraform resource data model to match the
+// resource schema.
+type NamespaceLockModel struct {
+	base.BaseModel
+
+	Path               types.String `tfsdk:"path"`
+	Locked             types.Bool   `tfsdk:"locked"`
+	UnlockKeyWO        types.String `tfsdk:"unlock_key_wo"`
+	UnlockKeyWOVersion types.Int64  `tfsdk:"unlock_key_wo_version"`
+}
+
+// Metadata defines the resource name as it would appear in Terraform configurations
+func (r *NamespaceLockResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_namespace_lock"
+}
+
+// Schema defines this resource's schema
+func (r *NamespaceLockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldPath: schema.StringAttribute{
+				MarkdownDescription: "Path of the child namespace to lock, relative to `namespace`. " +
+					"The namespace itself is locked if not set.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			fieldLocked: schema.BoolAttribute{
+				MarkdownDescription: "Whether the API of the namespace and its descendants is locked. " +
+					"Set to `false` to unlock the namespaces without destroying the resource. Defaults to `true`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			consts.FieldUnlockKeyWO: schema.StringAttribute{
+				MarkdownDescription: "Unlock key to use when unlocking the namespace. The key returned by Vault " +
+					"when the resource locks the namespace is not stored, so unlocking requires a root token " +
+					"if not set. This is a write-only field and is never stored in state.",
+				Optional:  true,
+				WriteOnly: true,
+				Sensitive: true,
+			},
+			consts.FieldUnlockKeyWOVersion: schema.Int64Attribute{
+				MarkdownDescription: "Version counter for the write-only `unlock_key_wo` field.",
+				Optional:            true,
+			},
+		},
+		MarkdownDescription: "Locks the API of a namespace and all its descendants. " +
+			"The namespaces are unlocked when the resource is destroyed.",
+	}
+
+	base.MustAddBaseSchema(&resp.Schema)
+}
+
+// ModifyPlan checks the capabilities of the provider token on the lock or
+// unlock path, see the plan_capability_check provider argument.
+func (r *NamespaceLockResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan NamespaceLockModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Path.IsUnknown() || plan.Locked.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state NamespaceLockModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || state.Locked.ValueBool() == plan.Locked.ValueBool() {
+			return
+		}
+	}
+
+	op := "unlock"
+	if plan.Locked.ValueBool() {
+		op = "lock"
+	}
+
+	r.CheckPlanCapabilities(ctx, req, resp, []string{namespaceLockPath(op, plan.Path.ValueString())})
+}
+
+// Create is called during the terraform apply command.
+func (r *NamespaceLockResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NamespaceLockModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	if data.Locked.ValueBool() {
+		if err := lockNamespace(ctx, c, data.Path.ValueString()); err != nil {
+			resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
+			return
+		}
+	} else {
+		// unlock a namespace that was locked outside of Terraform
+		unlockKey, diags := namespaceUnlockKey(ctx, req.Config)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if unlockKey != "" {
+			if err := unlockNamespace(ctx, c, data.Path.ValueString(), unlockKey); err != nil {
+				resp.Diagnostics.AddError(errutil.VaultCreateErr(err))
+				return
+			}
+		}
+	}
+
+	data.UnlockKeyWO = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read is called during the terraform plan and apply commands. Vault does not
+// report whether a namespace is locked, so the state is kept as is.
+func (r *NamespaceLockResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NamespaceLockModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update locks or unlocks the namespaces when locked changes.
+func (r *NamespaceLockResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state NamespaceLockModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Locked.ValueBool() != state.Locked.ValueBool() {
+		c, err := client.GetClient(ctx, r.Meta(), plan.Namespace.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+			return
+		}
+
+		if plan.Locked.ValueBool() {
+			if err := lockNamespace(ctx, c, plan.Path.ValueString()); err != nil {
+				resp.Diagnostics.AddError(errutil.VaultUpdateErr(err))
+				return
+			}
+		} else {
+			unlockKey, diags := namespaceUnlockKey(ctx, req.Config)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			if err := unlockNamespace(ctx, c, plan.Path.ValueString(), unlockKey); err != nil {
+				resp.Diagnostics.AddError(errutil.VaultUpdateErr(err))
+				return
+			}
+		}
+	}
+
+	plan.UnlockKeyWO = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete unlocks the namespaces if they are locked. The configuration, and so
+// unlock_key_wo, is not available on delete, so this requires a root token.
+func (r *NamespaceLockResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NamespaceLockModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || !data.Locked.ValueBool() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	if err := unlockNamespace(ctx, c, data.Path.ValueString(), ""); err != nil {
+		resp.Diagnostics.AddError(errutil.VaultDeleteErr(fmt.Errorf(
+			"%w; without a root token, set %q to false with %q before destroying the resource",
+			err, fieldLocked, consts.FieldUnlockKeyWO)))
+	}
+}
+
+// namespaceUnlockKey returns the write-only unlock key from the
+// configuration. The unlock key returned when the resource locks the namespace
+// is not stored, so without it unlocking requires a root token.
+func namespaceUnlockKey(ctx context.Context, config tfsdk.Config) (string, diag.Diagnostics) {
+	var unlockKey types.String
+	diags := config.GetAttribute(ctx, path.Root(consts.FieldUnlockKeyWO), &unlockKey)
+
+	return unlockKey.ValueString(), diags
+}
+
+func namespaceLockPath(op, p string) string {
+	p = strings.Trim(p, "/")
+	if p == "" {
+		return consts.SysNamespaceLock + op
+	}
+	return fmt.Sprintf("%s%s/%s", consts.SysNamespaceLock, op, p)
+}
+
+// lockNamespace locks the API of the namespace at p, relative to the
+// namespace of the client. The unlock key returned by Vault is discarded, so
+// that it is never persisted.
+func lockNamespace(ctx context.Context, c *api.Client, p string) error {
+	lockPath := namespaceLockPath("lock", p)
+	tflog.Debug(ctx, fmt.Sprintf("Locking namespace at %q", lockPath))
+
+	if _, err := c.Logical().WriteWithContext(ctx, lockPath, nil); err != nil {
+		return fmt.Errorf("error locking namespace at %q: %w", lockPath, err)
+	}
+
+	return nil
+}
+
+// unlockNamespace unlocks the API of the namespace at p, relative to the
+// namespace of the client. Without an unlock key, this requires a root token.
+func unlockNamespace(ctx context.Context, c *api.Client, p, unlockKey string) error {
+	unlockPath := namespaceLockPath("unlock", p)
+	tflog.Debug(ctx, fmt.Sprintf("Unlocking namespace at %q", unlockPath))
+
+	var data map[string]interface{}
+	if unlockKey != "" {
+		data = map[string]interface{}{
+			apiFieldUnlockKey: unlockKey,
+		}
+	}
+
+	if _, err := c.Logical().WriteWithContext(ctx, unlockPath, data); err != nil {
+		return fmt.Errorf("error unlocking namespace at %q: %w", unlockPath, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestNamespaceLockPath(t *testing.T) {
+	assert.Equal(t, "sys/namespaces/api-lock/lock", namespaceLockPath("lock", ""))
+	assert.Equal(t, "sys/namespaces/api-lock/lock/a/b", namespaceLockPath("lock", "/a/b/"))
+	assert.Equal(t, "sys/namespaces/api-lock/unlock/a", namespaceLockPath("unlock", "a"))
+}
+
+func TestLockUnlockNamespace(t *testing.T) {
+	var unlockBody map[string]interface{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Namespace") != "tenant" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v1/sys/namespaces/api-lock/lock/child":
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": map[string]interface{}{
+					"unlock_key": "s3cr3t",
+				},
+			})
+		case "/v1/sys/namespaces/api-lock/unlock/child":
+			unlockBody = nil
+			json.NewDecoder(r.Body).Decode(&unlockBody)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	config, ln := testutil.TestHTTPServer(t, handler)
+	defer ln.Close()
+
+	c, err := api.NewClient(config)
+	require.NoError(t, err)
+	c.SetNamespace("tenant")
+
+	require.NoError(t, lockNamespace(context.Background(), c, "child"))
+
+	require.NoError(t, unlockNamespace(context.Background(), c, "child", "s3cr3t"))
+	assert.Equal(t, map[string]interface{}{"unlock_key": "s3cr3t"}, unlockBody)
+
+	require.NoError(t, unlockNamespace(context.Background(), c, "child", ""))
+	assert.Nil(t, unlockBody)
+
+	assert.Error(t, lockNamespace(context.Background(), c, "other"))
+}
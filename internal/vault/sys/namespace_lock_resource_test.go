@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccNamespaceLock(t *testing.T) {
+	ns := acctest.RandomWithPrefix("tf-ns")
+	resourceName := "vault_namespace_lock.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctestutil.TestEntPreCheck(t)
+		},
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNamespaceLockConfig(ns, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldNamespace, ns),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldPath, "child"),
+					resource.TestCheckResourceAttr(resourceName, "locked", "true"),
+					resource.TestCheckNoResourceAttr(resourceName, consts.FieldUnlockKeyWO),
+				),
+			},
+			{
+				Config: testAccNamespaceLockConfig(ns, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "locked", "false"),
+				),
+			},
+			{
+				Config: testAccNamespaceLockConfig(ns, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "locked", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNamespaceLockConfig(ns string, locked bool) string {
+	return fmt.Sprintf(`
+resource "vault_namespace" "parent" {
+  path = %q
+}
+
+resource "vault_namespace" "child" {
+  namespace = vault_namespace.parent.path
+  path      = "child"
+}
+
+resource "vault_namespace_lock" "test" {
+  namespace = vault_namespace.parent.path
+  path      = vault_namespace.child.path
+  locked    = %t
+}
+`, ns, locked)
+}
@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/policy"
+)
+
+// Ensure the implementation satisfies the function.Function interface
+var _ function.Function = &PolicyDecodeFunction{}
+
+// NewPolicyDecodeFunction returns the implementation for this function
+var NewPolicyDecodeFunction = func() function.Function {
+	return &PolicyDecodeFunction{}
+}
+
+// PolicyDecodeFunction implements the policy_decode provider-defined function.
+// It parses a Vault ACL policy document without making any Vault request.
+type PolicyDecodeFunction struct{}
+
+var policyRuleAttrTypes = map[string]attr.Type{
+	consts.FieldPath:                types.StringType,
+	consts.FieldDescription:         types.StringType,
+	consts.FieldCapabilities:        types.ListType{ElemType: types.StringType},
+	consts.FieldRequiredParameters:  types.ListType{ElemType: types.StringType},
+	consts.FieldSubscribeEventTypes: types.ListType{ElemType: types.StringType},
+	consts.FieldAllowedParameters:   types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
+	consts.FieldDeniedParameters:    types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
+	consts.FieldMinWrappingTTL:      types.StringType,
+	consts.FieldMaxWrappingTTL:      types.StringType,
+}
+
+var policyAttrTypes = map[string]attr.Type{
+	consts.FieldRules: types.ListType{ElemType: types.ObjectType{AttrTypes: policyRuleAttrTypes}},
+}
+
+func (f *PolicyDecodeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_decode"
+}
+
+func (f *PolicyDecodeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a Vault policy document",
+		MarkdownDescription: "Parses a Vault ACL policy written in HCL into an object with a `rules` list, " +
+			"using the same rule model as the `vault_policy_document` data source. The lead comment of " +
+			"each `path` stanza is returned as the rule's `description`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "hcl",
+				MarkdownDescription: "The policy document to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: policyAttrTypes,
+		},
+	}
+}
+
+func (f *PolicyDecodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var hcl string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &hcl))
+	if resp.Error != nil {
+		return
+	}
+
+	p, err := policy.Parse(hcl)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := policyToObjectValue(ctx, p)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+func policyToObjectValue(ctx context.Context, p *policy.Policy) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rules := make([]attr.Value, 0, len(p.Rules))
+	for _, rule := range p.Rules {
+		values := map[string]attr.Value{
+			consts.FieldPath:           types.StringValue(rule.Path),
+			consts.FieldDescription:    optionalStringValue(rule.Description),
+			consts.FieldMinWrappingTTL: optionalStringValue(rule.MinWrappingTTL),
+			consts.FieldMaxWrappingTTL: optionalStringValue(rule.MaxWrappingTTL),
+		}
+
+		for k, v := range map[string][]string{
+			consts.FieldCapabilities:        rule.Capabilities,
+			consts.FieldRequiredParameters:  rule.RequiredParameters,
+			consts.FieldSubscribeEventTypes: rule.SubscribeEventTypes,
+		} {
+			l, d := optionalListValue(ctx, v)
+			diags.Append(d...)
+			values[k] = l
+		}
+
+		for k, v := range map[string]map[string][]string{
+			consts.FieldAllowedParameters: rule.AllowedParameters,
+			consts.FieldDeniedParameters:  rule.DeniedParameters,
+		} {
+			if v == nil {
+				values[k] = types.MapNull(types.ListType{ElemType: types.StringType})
+				continue
+			}
+			m, d := types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, v)
+			diags.Append(d...)
+			values[k] = m
+		}
+
+		obj, d := types.ObjectValue(policyRuleAttrTypes, values)
+		diags.Append(d...)
+		rules = append(rules, obj)
+	}
+
+	if diags.HasError() {
+		return types.ObjectNull(policyAttrTypes), diags
+	}
+
+	list, d := types.ListValue(types.ObjectType{AttrTypes: policyRuleAttrTypes}, rules)
+	diags.Append(d...)
+
+	obj, d := types.ObjectValue(policyAttrTypes, map[string]attr.Value{
+		consts.FieldRules: list,
+	})
+	diags.Append(d...)
+
+	return obj, diags
+}
+
+func optionalStringValue(v string) types.String {
+	if v == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(v)
+}
+
+func optionalListValue(ctx context.Context, v []string) (types.List, diag.Diagnostics) {
+	if v == nil {
+		return types.ListNull(types.StringType), nil
+	}
+	return types.ListValueFrom(ctx, types.StringType, v)
+}
@@ -0,0 +1,275 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/policy"
+)
+
+// Ensure the implementation satisfies the function.Function interface
+var _ function.Function = &PolicyEncodeFunction{}
+
+// NewPolicyEncodeFunction returns the implementation for this function
+var NewPolicyEncodeFunction = func() function.Function {
+	return &PolicyEncodeFunction{}
+}
+
+// PolicyEncodeFunction implements the policy_encode provider-defined function.
+// It renders a policy object as a Vault ACL policy document without making
+// any Vault request.
+type PolicyEncodeFunction struct{}
+
+func (f *PolicyEncodeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_encode"
+}
+
+func (f *PolicyEncodeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Render a Vault policy document",
+		MarkdownDescription: "Renders an object with a `rules` list as a Vault ACL policy in HCL. " +
+			"The object has the same shape as the result of `policy_decode`; only `path` and " +
+			"`capabilities` are required on each rule, all other rule attributes may be omitted or null.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "policy",
+				MarkdownDescription: "The policy object to render.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *PolicyEncodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input types.Dynamic
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	if input.IsUnderlyingValueNull() {
+		resp.Error = function.NewArgumentFuncError(0, "policy must not be null")
+		return
+	}
+
+	v, err := input.UnderlyingValue().ToTerraformValue(ctx)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	p, err := policyFromTerraformValue(v)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, policy.Render(p)))
+}
+
+// policyFromTerraformValue converts an arbitrary object or map value into a
+// Policy. Both object and map values are accepted for the policy and its
+// rules, and lists, sets and tuples are accepted for all list attributes, so
+// that literal HCL expressions can be passed without type conversion.
+func policyFromTerraformValue(v tftypes.Value) (*policy.Policy, error) {
+	attrs, err := tfAttributes(v)
+	if err != nil {
+		return nil, fmt.Errorf("policy: %w", err)
+	}
+
+	for k := range attrs {
+		if k != consts.FieldRules {
+			return nil, fmt.Errorf("policy: unsupported attribute %q", k)
+		}
+	}
+
+	elems, err := tfElements(attrs[consts.FieldRules])
+	if err != nil {
+		return nil, fmt.Errorf("policy.%s: %w", consts.FieldRules, err)
+	}
+
+	p := &policy.Policy{}
+	for i, elem := range elems {
+		rule, err := policyRuleFromTerraformValue(elem)
+		if err != nil {
+			return nil, fmt.Errorf("policy.%s[%d]: %w", consts.FieldRules, i, err)
+		}
+		p.Rules = append(p.Rules, rule)
+	}
+
+	return p, nil
+}
+
+func policyRuleFromTerraformValue(v tftypes.Value) (*policy.PolicyRule, error) {
+	attrs, err := tfAttributes(v)
+	if err != nil {
+		return nil, err
+	}
+
+	for k := range attrs {
+		if _, ok := policyRuleAttrTypes[k]; !ok {
+			return nil, fmt.Errorf("unsupported attribute %q", k)
+		}
+	}
+
+	rule := &policy.PolicyRule{}
+	for k, s := range map[string]*string{
+		consts.FieldPath:           &rule.Path,
+		consts.FieldDescription:    &rule.Description,
+		consts.FieldMinWrappingTTL: &rule.MinWrappingTTL,
+		consts.FieldMaxWrappingTTL: &rule.MaxWrappingTTL,
+	} {
+		if *s, err = tfString(attrs[k]); err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+	}
+
+	if rule.Path == "" {
+		return nil, fmt.Errorf("missing required attribute %q", consts.FieldPath)
+	}
+
+	for k, l := range map[string]*[]string{
+		consts.FieldCapabilities:        &rule.Capabilities,
+		consts.FieldRequiredParameters:  &rule.RequiredParameters,
+		consts.FieldSubscribeEventTypes: &rule.SubscribeEventTypes,
+	} {
+		if *l, err = tfStringList(attrs[k]); err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+	}
+
+	if len(rule.Capabilities) == 0 {
+		return nil, fmt.Errorf("missing required attribute %q", consts.FieldCapabilities)
+	}
+
+	for _, c := range rule.Capabilities {
+		if !policy.IsValidCapability(c) {
+			return nil, fmt.Errorf("%s: invalid capability %q", consts.FieldCapabilities, c)
+		}
+	}
+
+	for k, m := range map[string]*map[string][]string{
+		consts.FieldAllowedParameters: &rule.AllowedParameters,
+		consts.FieldDeniedParameters:  &rule.DeniedParameters,
+	} {
+		params, err := tfAttributes(attrs[k])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		if len(params) == 0 {
+			continue
+		}
+
+		*m = make(map[string][]string, len(params))
+		for name, values := range params {
+			if (*m)[name], err = tfStringList(values); err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", k, name, err)
+			}
+			if (*m)[name] == nil {
+				(*m)[name] = []string{}
+			}
+		}
+	}
+
+	return rule, nil
+}
+
+// tfAttributes returns the attributes of an object or the elements of a map.
+// A missing or null value yields a nil map.
+func tfAttributes(v tftypes.Value) (map[string]tftypes.Value, error) {
+	if v.Type() == nil || v.IsNull() {
+		return nil, nil
+	}
+	if !v.IsKnown() {
+		return nil, fmt.Errorf("value must be known")
+	}
+
+	switch {
+	case v.Type().Is(tftypes.Object{}), v.Type().Is(tftypes.Map{}):
+		var attrs map[string]tftypes.Value
+		if err := v.As(&attrs); err != nil {
+			return nil, err
+		}
+		return attrs, nil
+	default:
+		return nil, fmt.Errorf("expected an object or map, got %s", v.Type())
+	}
+}
+
+// tfElements returns the elements of a list, set or tuple. A missing or null
+// value yields a nil slice.
+func tfElements(v tftypes.Value) ([]tftypes.Value, error) {
+	if v.Type() == nil || v.IsNull() {
+		return nil, nil
+	}
+	if !v.IsKnown() {
+		return nil, fmt.Errorf("value must be known")
+	}
+
+	switch {
+	case v.Type().Is(tftypes.List{}), v.Type().Is(tftypes.Set{}), v.Type().Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+		return elems, nil
+	default:
+		return nil, fmt.Errorf("expected a list, got %s", v.Type())
+	}
+}
+
+// tfString returns the string form of a primitive value. A missing or null
+// value yields the empty string.
+func tfString(v tftypes.Value) (string, error) {
+	if v.Type() == nil || v.IsNull() {
+		return "", nil
+	}
+	if !v.IsKnown() {
+		return "", fmt.Errorf("value must be known")
+	}
+
+	switch {
+	case v.Type().Is(tftypes.String):
+		var s string
+		err := v.As(&s)
+		return s, err
+	case v.Type().Is(tftypes.Number):
+		var n big.Float
+		if err := v.As(&n); err != nil {
+			return "", err
+		}
+		return n.Text('f', -1), nil
+	case v.Type().Is(tftypes.Bool):
+		var b bool
+		if err := v.As(&b); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%t", b), nil
+	default:
+		return "", fmt.Errorf("expected a string, got %s", v.Type())
+	}
+}
+
+func tfStringList(v tftypes.Value) ([]string, error) {
+	elems, err := tfElements(v)
+	if err != nil || elems == nil {
+		return nil, err
+	}
+
+	result := make([]string, len(elems))
+	for i, elem := range elems {
+		if result[i], err = tfString(elem); err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+	return result, nil
+}
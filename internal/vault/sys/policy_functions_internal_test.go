@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/policy"
+)
+
+func TestPolicyEncodeDecodeRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	p := &policy.Policy{
+		Rules: []*policy.PolicyRule{
+			{
+				Path:         `secret/"quoted"/*`,
+				Description:  "first line\nsecond line",
+				Capabilities: []string{"read", "list"},
+				AllowedParameters: map[string][]string{
+					"spam": {`"eggs"`},
+				},
+				MaxWrappingTTL: "1h",
+			},
+		},
+	}
+
+	want, diags := policyToObjectValue(ctx, p)
+	require.False(t, diags.HasError(), diags)
+
+	encodeResp := &function.RunResponse{
+		Result: function.NewResultData(types.StringUnknown()),
+	}
+	(&PolicyEncodeFunction{}).Run(ctx, function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.DynamicValue(want)}),
+	}, encodeResp)
+	require.Nil(t, encodeResp.Error)
+
+	rendered, ok := encodeResp.Result.Value().(types.String)
+	require.True(t, ok)
+
+	decodeResp := &function.RunResponse{
+		Result: function.NewResultData(types.ObjectUnknown(policyAttrTypes)),
+	}
+	(&PolicyDecodeFunction{}).Run(ctx, function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{rendered}),
+	}, decodeResp)
+	require.Nil(t, decodeResp.Error)
+
+	assert.Equal(t, want, decodeResp.Result.Value())
+}
@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccPolicyFunctions(t *testing.T) {
+	expectedHCL := `# read secrets
+path "secret/data/*" {
+  capabilities = ["read", "list"]
+}
+
+path "sys/mounts" {
+  capabilities = ["read"]
+  allowed_parameters = {
+    "type" = ["kv"]
+  }
+  max_wrapping_ttl = "1h"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyFunctionsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("rule_count", "2"),
+					resource.TestCheckOutput("first_path", "secret/data/*"),
+					resource.TestCheckOutput("first_description", "read secrets"),
+					resource.TestCheckOutput("round_trip", expectedHCL),
+					resource.TestCheckOutput("merged", expectedHCL+`
+path "auth/token/lookup-self" {
+  capabilities = ["read"]
+}
+`),
+				),
+			},
+			{
+				Config:      `output "invalid" { value = provider::vault::policy_decode("path \"secret/*\" { capabilities = [\"write\"] }") }`,
+				ExpectError: regexp.MustCompile(`invalid capability "write"`),
+			},
+			{
+				Config:      `output "invalid" { value = provider::vault::policy_encode({ rules = [{ path = "secret/*" }] }) }`,
+				ExpectError: regexp.MustCompile(`missing required attribute "capabilities"`),
+			},
+		},
+	})
+}
+
+const testAccPolicyFunctionsConfig = `
+locals {
+  policy = provider::vault::policy_decode(<<-EOT
+    # read secrets
+    path "secret/data/*" {
+      capabilities = ["read", "list"]
+    }
+
+    path "sys/mounts" {
+      capabilities       = ["read"]
+      allowed_parameters = { "type" = ["kv"] }
+      max_wrapping_ttl   = "1h"
+    }
+  EOT
+  )
+}
+
+output "rule_count" {
+  value = length(local.policy.rules)
+}
+
+output "first_path" {
+  value = local.policy.rules[0].path
+}
+
+output "first_description" {
+  value = local.policy.rules[0].description
+}
+
+output "round_trip" {
+  value = provider::vault::policy_encode(local.policy)
+}
+
+output "merged" {
+  value = provider::vault::policy_encode({
+    rules = concat(local.policy.rules, [{
+      path         = "auth/token/lookup-self"
+      capabilities = ["read"]
+    }])
+  })
+}
+`
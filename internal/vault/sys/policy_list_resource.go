@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+var _ list.ListResourceWithConfigure = &PolicyListResource{}
+var _ list.ListResourceWithRawV5Schemas = &PolicyListResource{}
+
+// NewPolicyListResource returns the implementation for this list resource to
+// be used by the Terraform Plugin Framework provider.
+func NewPolicyListResource() list.ListResource {
+	return &PolicyListResource{}
+}
+
+// PolicyListResource lists the ACL policies of a namespace as vault_policy
+// resources.
+type PolicyListResource struct {
+	base.SDKv2ListResource
+}
+
+// Metadata defines the list resource name, it matches the listed resource.
+func (r *PolicyListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy"
+}
+
+// ListResourceConfigSchema defines the list resource configuration schema.
+func (r *PolicyListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the ACL policies in Vault. The `root` policy is not listed.",
+		Attributes:          map[string]schema.Attribute{},
+	}
+
+	base.MustAddBaseListSchema(&resp.Schema)
+}
+
+// List streams the ACL policies of the namespace.
+func (r *PolicyListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data base.BaseListModel
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		stream.Results = base.ListResultsError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	names, err := c.Sys().ListPoliciesWithContext(ctx)
+	if err != nil {
+		stream.Results = base.ListResultsError(errutil.VaultReadErr(err))
+		return
+	}
+
+	var objects []base.ListObject
+	for _, name := range names {
+		if name == "root" {
+			continue
+		}
+
+		objects = append(objects, base.ListObject{
+			ID:          name,
+			DisplayName: name,
+		})
+	}
+
+	stream.Results = r.Results(ctx, req, data.Namespace.ValueString(), objects)
+}
@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccPolicyListResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-test-policy")
+	config := fmt.Sprintf(`
+resource "vault_policy" "test" {
+  name   = "%s"
+  policy = <<EOT
+path "secret/*" {
+  capabilities = ["read"]
+}
+EOT
+}
+`, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				Query: true,
+				Config: `
+list "vault_policy" "test" {
+  provider         = vault
+  include_resource = true
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("vault_policy.test", 2),
+					querycheck.ExpectIdentity("vault_policy.test", map[string]knownvalue.Check{
+						consts.FieldID:        knownvalue.StringExact(name),
+						consts.FieldNamespace: knownvalue.Null(),
+					}),
+					querycheck.ExpectResourceKnownValues("vault_policy.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact(name)),
+						[]querycheck.KnownValueCheck{
+							{
+								Path:       tfjsonpath.New(consts.FieldName),
+								KnownValue: knownvalue.StringExact(name),
+							},
+							{
+								Path:       tfjsonpath.New("policy"),
+								KnownValue: knownvalue.StringRegexp(regexp.MustCompile(`path "secret/\*"`)),
+							},
+						},
+					),
+				},
+			},
+			{
+				Config:          config,
+				ResourceName:    "vault_policy.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
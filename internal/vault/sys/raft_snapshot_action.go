@@ -0,0 +1,308 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+// raftSnapshotChecksumSuffix is appended to the snapshot file path to get the
+// default path of its checksum file.
+const raftSnapshotChecksumSuffix = ".sha256"
+
+var (
+	_ action.ActionWithConfigure = &RaftSnapshotAction{}
+	_ action.ActionWithConfigure = &RaftSnapshotRestoreAction{}
+)
+
+// NewRaftSnapshotAction returns the implementation for this action
+var NewRaftSnapshotAction = func() action.Action {
+	return &RaftSnapshotAction{}
+}
+
+// NewRaftSnapshotRestoreAction returns the implementation for this action
+var NewRaftSnapshotRestoreAction = func() action.Action {
+	return &RaftSnapshotRestoreAction{}
+}
+
+// RaftSnapshotAction saves a snapshot of the Raft storage to a local file.
+type RaftSnapshotAction struct {
+	base.ActionWithConfigure
+}
+
+// RaftSnapshotModel describes the Terraform action data model
+type RaftSnapshotModel struct {
+	base.BaseActionModel
+
+	Path         types.String `tfsdk:"path"`
+	ChecksumFile types.String `tfsdk:"checksum_file"`
+}
+
+// RaftSnapshotRestoreAction restores a snapshot of the Raft storage from a
+// local file.
+type RaftSnapshotRestoreAction struct {
+	base.ActionWithConfigure
+}
+
+// RaftSnapshotRestoreModel describes the Terraform action data model
+type RaftSnapshotRestoreModel struct {
+	base.BaseActionModel
+
+	Path         types.String `tfsdk:"path"`
+	ChecksumFile types.String `tfsdk:"checksum_file"`
+	SHA256       types.String `tfsdk:"sha256"`
+	Force        types.Bool   `tfsdk:"force"`
+}
+
+func (a *RaftSnapshotAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_raft_snapshot"
+}
+
+func (a *RaftSnapshotAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Saves a snapshot of the Raft storage to a local file, along with its SHA-256 checksum.",
+		Attributes: map[string]schema.Attribute{
+			consts.FieldPath: schema.StringAttribute{
+				MarkdownDescription: "Local path of the snapshot file. The file is replaced once the snapshot is complete.",
+				Required:            true,
+			},
+			consts.FieldChecksumFile: schema.StringAttribute{
+				MarkdownDescription: "Local path of the file where the SHA-256 checksum of the snapshot is written, " +
+					"in the format of `sha256sum`. Defaults to `path` with the `.sha256` suffix.",
+				Optional: true,
+			},
+		},
+	}
+	base.MustAddBaseActionSchema(&resp.Schema)
+}
+
+func (a *RaftSnapshotAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data RaftSnapshotModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, a.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	snapshotPath := data.Path.ValueString()
+	checksumPath := raftSnapshotChecksumPath(snapshotPath, data.ChecksumFile.ValueString())
+
+	base.SendProgress(resp, "Saving Raft snapshot to %q", snapshotPath)
+
+	sum, size, err := saveRaftSnapshot(ctx, c, snapshotPath, checksumPath)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error saving Raft snapshot",
+			fmt.Sprintf("Error saving Raft snapshot to %q: %s", snapshotPath, err),
+		)
+		return
+	}
+
+	base.SendProgress(resp, "Saved Raft snapshot to %q (%d bytes, sha256 %s)", snapshotPath, size, sum)
+}
+
+func (a *RaftSnapshotRestoreAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_raft_snapshot_restore"
+}
+
+func (a *RaftSnapshotRestoreAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Restores a snapshot of the Raft storage from a local file, after checking its SHA-256 checksum.",
+		Attributes: map[string]schema.Attribute{
+			consts.FieldPath: schema.StringAttribute{
+				MarkdownDescription: "Local path of the snapshot file.",
+				Required:            true,
+			},
+			consts.FieldChecksumFile: schema.StringAttribute{
+				MarkdownDescription: "Local path of the checksum file written by the `vault_raft_snapshot` action. " +
+					"Defaults to `path` with the `.sha256` suffix. The checksum is not checked if " +
+					"the default file does not exist and `sha256` is not set.",
+				Optional: true,
+			},
+			consts.FieldSHA256: schema.StringAttribute{
+				MarkdownDescription: "Expected hex encoded SHA-256 checksum of the snapshot. Takes precedence over `checksum_file`.",
+				Optional:            true,
+			},
+			consts.FieldForce: schema.BoolAttribute{
+				MarkdownDescription: "Restore the snapshot even if it was taken on a cluster with different keys. " +
+					"Use with caution.",
+				Optional: true,
+			},
+		},
+	}
+	base.MustAddBaseActionSchema(&resp.Schema)
+}
+
+func (a *RaftSnapshotRestoreAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data RaftSnapshotRestoreModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, a.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	snapshotPath := data.Path.ValueString()
+	expected := strings.ToLower(strings.TrimSpace(data.SHA256.ValueString()))
+	if expected == "" {
+		checksumPath := data.ChecksumFile.ValueString()
+		expected, err = readRaftSnapshotChecksum(raftSnapshotChecksumPath(snapshotPath, checksumPath), checksumPath != "")
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading Raft snapshot checksum", err.Error())
+			return
+		}
+	}
+
+	base.SendProgress(resp, "Restoring Raft snapshot from %q", snapshotPath)
+
+	if err := restoreRaftSnapshot(ctx, c, snapshotPath, expected, data.Force.ValueBool()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error restoring Raft snapshot",
+			fmt.Sprintf("Error restoring Raft snapshot from %q: %s", snapshotPath, err),
+		)
+		return
+	}
+
+	base.SendProgress(resp, "Restored Raft snapshot from %q", snapshotPath)
+}
+
+func raftSnapshotChecksumPath(snapshotPath, checksumPath string) string {
+	if checksumPath != "" {
+		return checksumPath
+	}
+	return snapshotPath + raftSnapshotChecksumSuffix
+}
+
+// saveRaftSnapshot streams a snapshot to a temporary file next to
+// snapshotPath while hashing it, then moves it to snapshotPath and writes the
+// checksum file. It returns the hex encoded checksum and the size of the
+// snapshot.
+func saveRaftSnapshot(ctx context.Context, c *api.Client, snapshotPath, checksumPath string) (string, int64, error) {
+	f, err := os.CreateTemp(filepath.Dir(snapshotPath), filepath.Base(snapshotPath)+".*.tmp")
+	if err != nil {
+		return "", 0, err
+	}
+	tmpPath := f.Name()
+	defer os.Remove(tmpPath)
+
+	h := sha256.New()
+	w := &countingWriter{w: io.MultiWriter(f, h)}
+	if err := c.Sys().RaftSnapshotWithContext(ctx, w); err != nil {
+		f.Close()
+		return "", 0, err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return "", 0, err
+	}
+	if err := f.Close(); err != nil {
+		return "", 0, err
+	}
+
+	if err := os.Rename(tmpPath, snapshotPath); err != nil {
+		return "", 0, err
+	}
+
+	sum := hex.EncodeToString(h.Sum(nil))
+	line := fmt.Sprintf("%s  %s\n", sum, filepath.Base(snapshotPath))
+	if err := os.WriteFile(checksumPath, []byte(line), 0o600); err != nil {
+		return "", 0, fmt.Errorf("error writing checksum file %q: %w", checksumPath, err)
+	}
+
+	return sum, w.n, nil
+}
+
+// readRaftSnapshotChecksum reads the checksum from a file in the format of
+// sha256sum. A missing file is only an error if required is true.
+func readRaftSnapshotChecksum(checksumPath string, required bool) (string, error) {
+	b, err := os.ReadFile(checksumPath)
+	if err != nil {
+		if !required && errors.Is(err, fs.ErrNotExist) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	fields := strings.Fields(string(b))
+	if len(fields) == 0 {
+		return "", fmt.Errorf("checksum file %q is empty", checksumPath)
+	}
+
+	return strings.ToLower(fields[0]), nil
+}
+
+// restoreRaftSnapshot checks the checksum of the snapshot, if expected is set,
+// then streams it to Vault.
+func restoreRaftSnapshot(ctx context.Context, c *api.Client, snapshotPath, expected string, force bool) error {
+	f, err := os.Open(snapshotPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if expected != "" {
+		sum, err := hashReader(sha256.New(), f)
+		if err != nil {
+			return err
+		}
+		if sum != expected {
+			return fmt.Errorf("checksum mismatch, expected sha256 %s, got %s", expected, sum)
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+	}
+
+	// *os.File is an io.ReadSeeker, so the body is streamed rather than
+	// buffered in memory by the HTTP client.
+	return c.Sys().RaftSnapshotRestoreWithContext(ctx, f, force)
+}
+
+func hashReader(h hash.Hash, r io.Reader) (string, error) {
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// countingWriter counts the bytes written to w.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
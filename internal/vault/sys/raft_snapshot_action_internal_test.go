@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func testRaftSnapshot(t *testing.T) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for name, content := range map[string]string{
+		"meta.json":         `{"ID":"snapshot"}`,
+		"state.bin":         "raft state",
+		"SHA256SUMS.sealed": "sealed sums",
+	} {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(content))}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+
+	return buf.Bytes()
+}
+
+func TestRaftSnapshotSaveRestore(t *testing.T) {
+	snapshot := testRaftSnapshot(t)
+	digest := sha256.Sum256(snapshot)
+	wantSum := hex.EncodeToString(digest[:])
+
+	var restoredPath string
+	var restored []byte
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/sys/storage/raft/snapshot":
+			w.Write(snapshot)
+		case r.Method == http.MethodPost:
+			restoredPath = r.URL.Path
+			restored, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	config, ln := testutil.TestHTTPServer(t, handler)
+	defer ln.Close()
+
+	c, err := api.NewClient(config)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	snapshotPath := filepath.Join(dir, "vault.snap")
+	checksumPath := raftSnapshotChecksumPath(snapshotPath, "")
+
+	sum, size, err := saveRaftSnapshot(context.Background(), c, snapshotPath, checksumPath)
+	require.NoError(t, err)
+	assert.Equal(t, wantSum, sum)
+	assert.Equal(t, int64(len(snapshot)), size)
+
+	b, err := os.ReadFile(snapshotPath)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, b)
+
+	b, err = os.ReadFile(checksumPath)
+	require.NoError(t, err)
+	assert.Equal(t, wantSum+"  vault.snap\n", string(b))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2, "the temporary file should be removed")
+
+	expected, err := readRaftSnapshotChecksum(checksumPath, true)
+	require.NoError(t, err)
+	assert.Equal(t, wantSum, expected)
+
+	require.NoError(t, restoreRaftSnapshot(context.Background(), c, snapshotPath, expected, false))
+	assert.Equal(t, "/v1/sys/storage/raft/snapshot", restoredPath)
+	assert.Equal(t, snapshot, restored)
+
+	require.NoError(t, restoreRaftSnapshot(context.Background(), c, snapshotPath, "", true))
+	assert.Equal(t, "/v1/sys/storage/raft/snapshot-force", restoredPath)
+
+	restoredPath = ""
+	err = restoreRaftSnapshot(context.Background(), c, snapshotPath, "deadbeef", false)
+	assert.ErrorContains(t, err, "checksum mismatch")
+	assert.Empty(t, restoredPath, "a snapshot with a checksum mismatch should not be restored")
+}
+
+func TestReadRaftSnapshotChecksum(t *testing.T) {
+	dir := t.TempDir()
+	missing := filepath.Join(dir, "missing.sha256")
+
+	sum, err := readRaftSnapshotChecksum(missing, false)
+	require.NoError(t, err)
+	assert.Empty(t, sum)
+
+	_, err = readRaftSnapshotChecksum(missing, true)
+	assert.Error(t, err)
+
+	empty := filepath.Join(dir, "empty.sha256")
+	require.NoError(t, os.WriteFile(empty, nil, 0o600))
+	_, err = readRaftSnapshotChecksum(empty, false)
+	assert.Error(t, err)
+}
+
+func TestSaveRaftSnapshotIncomplete(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("not a snapshot"))
+	})
+
+	config, ln := testutil.TestHTTPServer(t, handler)
+	defer ln.Close()
+
+	c, err := api.NewClient(config)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	snapshotPath := filepath.Join(dir, "vault.snap")
+	_, _, err = saveRaftSnapshot(context.Background(), c, snapshotPath, snapshotPath+raftSnapshotChecksumSuffix)
+	assert.Error(t, err)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "an incomplete snapshot should not be kept")
+}
@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccRaftSnapshotAction(t *testing.T) {
+	snapshotPath := filepath.Join(t.TempDir(), "vault.snap")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testutil.SkipTestEnvSet(t, "SKIP_RAFT_TESTS")
+			acctestutil.TestAccPreCheck(t)
+		},
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRaftSnapshotActionConfig(snapshotPath, "vault_raft_snapshot"),
+				Check:  testAccCheckRaftSnapshotFile(snapshotPath),
+			},
+			{
+				Config: testAccRaftSnapshotActionConfig(snapshotPath, "vault_raft_snapshot_restore"),
+			},
+		},
+	})
+}
+
+func testAccRaftSnapshotActionConfig(snapshotPath, action string) string {
+	return fmt.Sprintf(`
+action "%[2]s" "test" {
+  config {
+    path = %[1]q
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = %[2]q
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.%[2]s.test]
+    }
+  }
+}
+`, snapshotPath, action)
+}
+
+func testAccCheckRaftSnapshotFile(snapshotPath string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		info, err := os.Stat(snapshotPath)
+		if err != nil {
+			return err
+		}
+		if info.Size() == 0 {
+			return fmt.Errorf("snapshot %q is empty", snapshotPath)
+		}
+
+		b, err := os.ReadFile(snapshotPath + ".sha256")
+		if err != nil {
+			return err
+		}
+		if !strings.HasSuffix(strings.TrimSpace(string(b)), filepath.Base(snapshotPath)) {
+			return fmt.Errorf("unexpected checksum file content %q", b)
+		}
+
+		return nil
+	}
+}
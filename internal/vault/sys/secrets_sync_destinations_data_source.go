@@ -0,0 +1,243 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	syncutil "github.com/hashicorp/terraform-provider-vault/internal/sync"
+)
+
+const secretsSyncDestinationsPath = "sys/sync/destinations"
+
+var _ datasource.DataSourceWithConfigure = &SecretsSyncDestinationsDataSource{}
+
+// NewSecretsSyncDestinationsDataSource returns the implementation for this data source
+func NewSecretsSyncDestinationsDataSource() datasource.DataSource {
+	return &SecretsSyncDestinationsDataSource{}
+}
+
+// SecretsSyncDestinationsDataSource implements the methods that define this data source
+type SecretsSyncDestinationsDataSource struct {
+	base.DataSourceWithConfigure
+}
+
+// SecretsSyncDestinationsModel describes the Terraform data source data model
+type SecretsSyncDestinationsModel struct {
+	base.BaseModel
+
+	Type         types.String                  `tfsdk:"type"`
+	Destinations []SecretsSyncDestinationModel `tfsdk:"destinations"`
+}
+
+// SecretsSyncDestinationModel describes a destination and the secrets that
+// are associated with it.
+type SecretsSyncDestinationModel struct {
+	Name         types.String                  `tfsdk:"name"`
+	Type         types.String                  `tfsdk:"type"`
+	Associations []SecretsSyncAssociationModel `tfsdk:"associations"`
+}
+
+// SecretsSyncAssociationModel describes the sync status of an associated
+// secret.
+type SecretsSyncAssociationModel struct {
+	Accessor   types.String `tfsdk:"accessor"`
+	SecretName types.String `tfsdk:"secret_name"`
+	SubKey     types.String `tfsdk:"sub_key"`
+	SyncStatus types.String `tfsdk:"sync_status"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+	LastError  types.String `tfsdk:"last_error"`
+}
+
+func (d *SecretsSyncDestinationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets_sync_destinations"
+}
+
+func (d *SecretsSyncDestinationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldNamespace: schema.StringAttribute{
+				MarkdownDescription: "Target namespace.",
+				Optional:            true,
+			},
+			consts.FieldType: schema.StringAttribute{
+				MarkdownDescription: "Only list the destinations of this type, e.g. `aws-sm` or `gh`.",
+				Optional:            true,
+			},
+			"destinations": schema.ListNestedAttribute{
+				MarkdownDescription: "The sync destinations, sorted by type and name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						consts.FieldName: schema.StringAttribute{
+							MarkdownDescription: "Name of the destination.",
+							Computed:            true,
+						},
+						consts.FieldType: schema.StringAttribute{
+							MarkdownDescription: "Type of the destination.",
+							Computed:            true,
+						},
+						"associations": schema.ListNestedAttribute{
+							MarkdownDescription: "The secrets associated with the destination, sorted by mount accessor, secret name and sub-key.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"accessor": schema.StringAttribute{
+										MarkdownDescription: "Accessor of the mount of the secret.",
+										Computed:            true,
+									},
+									"secret_name": schema.StringAttribute{
+										MarkdownDescription: "Name of the secret.",
+										Computed:            true,
+									},
+									"sub_key": schema.StringAttribute{
+										MarkdownDescription: "Sub-key of the secret, set if the destination's granularity is `secret-key`.",
+										Computed:            true,
+									},
+									"sync_status": schema.StringAttribute{
+										MarkdownDescription: "Sync status of the secret, e.g. `SYNCED` or `UNSYNCED`.",
+										Computed:            true,
+									},
+									"updated_at": schema.StringAttribute{
+										MarkdownDescription: "Time at which the secret was last synced.",
+										Computed:            true,
+									},
+									"last_error": schema.StringAttribute{
+										MarkdownDescription: "Last error reported for the sync of the secret, if any.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		MarkdownDescription: "Lists the Secrets Sync destinations and the sync status of their associated secrets.",
+	}
+}
+
+func (d *SecretsSyncDestinationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SecretsSyncDestinationsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !provider.IsAPISupported(d.Meta(), provider.VaultVersion116) {
+		resp.Diagnostics.AddError("Unsupported Vault Version", "secrets sync requires Vault 1.16 or later")
+		return
+	}
+
+	c, err := client.GetClient(ctx, d.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	destinations, err := listSecretsSyncDestinations(ctx, c, data.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+		return
+	}
+
+	data.Destinations = make([]SecretsSyncDestinationModel, 0, len(destinations))
+	for _, dest := range destinations {
+		associations, err := readSecretsSyncAssociations(ctx, c, dest[1], dest[0])
+		if err != nil {
+			resp.Diagnostics.AddError(errutil.VaultReadErr(err))
+			return
+		}
+
+		data.Destinations = append(data.Destinations, SecretsSyncDestinationModel{
+			Type:         types.StringValue(dest[0]),
+			Name:         types.StringValue(dest[1]),
+			Associations: associations,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listSecretsSyncDestinations returns the type and name of each destination,
+// sorted by type and name.
+func listSecretsSyncDestinations(ctx context.Context, c *api.Client, typ string) ([][2]string, error) {
+	resp, err := c.Logical().ListWithContext(ctx, secretsSyncDestinationsPath)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, nil
+	}
+
+	keyInfo, _ := resp.Data["key_info"].(map[string]interface{})
+
+	var destinations [][2]string
+	for destType, v := range keyInfo {
+		if typ != "" && destType != typ {
+			continue
+		}
+
+		names, _ := v.([]interface{})
+		for _, name := range names {
+			if s, ok := name.(string); ok {
+				destinations = append(destinations, [2]string{destType, s})
+			}
+		}
+	}
+
+	sort.Slice(destinations, func(i, j int) bool {
+		if destinations[i][0] == destinations[j][0] {
+			return destinations[i][1] < destinations[j][1]
+		}
+		return destinations[i][0] < destinations[j][0]
+	})
+
+	return destinations, nil
+}
+
+// readSecretsSyncAssociations returns the secrets associated with a
+// destination, sorted by mount accessor, secret name and sub-key.
+func readSecretsSyncAssociations(ctx context.Context, c *api.Client, name, typ string) ([]SecretsSyncAssociationModel, error) {
+	path := fmt.Sprintf("%s/associations", syncutil.SecretsSyncDestinationPath(name, typ))
+	resp, err := c.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	associations := []SecretsSyncAssociationModel{}
+	if resp == nil {
+		return associations, nil
+	}
+
+	apiAssociations, err := syncutil.DecodeSyncAssociations(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to translate the associations of %q: %w", path, err)
+	}
+
+	for _, v := range apiAssociations {
+		associations = append(associations, SecretsSyncAssociationModel{
+			Accessor:   types.StringValue(v.Accessor),
+			SecretName: types.StringValue(v.SecretName),
+			SubKey:     types.StringValue(v.SubKey),
+			SyncStatus: types.StringValue(v.SyncStatus),
+			UpdatedAt:  types.StringValue(v.UpdatedAt),
+			LastError:  types.StringValue(v.LastError),
+		})
+	}
+
+	return associations, nil
+}
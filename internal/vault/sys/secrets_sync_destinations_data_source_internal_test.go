@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestListSecretsSyncDestinations(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/sys/sync/destinations" || r.URL.Query().Get("list") != "true" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"keys": []string{"gh", "aws-sm"},
+				"key_info": map[string]interface{}{
+					"gh":     []string{"repo-b", "repo-a"},
+					"aws-sm": []string{"prod"},
+				},
+			},
+		})
+	})
+
+	config, ln := testutil.TestHTTPServer(t, handler)
+	defer ln.Close()
+
+	c, err := api.NewClient(config)
+	require.NoError(t, err)
+
+	destinations, err := listSecretsSyncDestinations(context.Background(), c, "")
+	require.NoError(t, err)
+	assert.Equal(t, [][2]string{
+		{"aws-sm", "prod"},
+		{"gh", "repo-a"},
+		{"gh", "repo-b"},
+	}, destinations)
+
+	destinations, err = listSecretsSyncDestinations(context.Background(), c, "gh")
+	require.NoError(t, err)
+	assert.Equal(t, [][2]string{
+		{"gh", "repo-a"},
+		{"gh", "repo-b"},
+	}, destinations)
+}
+
+func TestReadSecretsSyncAssociations(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/sys/sync/destinations/gh/repo/associations" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"associated_secrets": map[string]interface{}{
+					"kv_1234/b": map[string]interface{}{
+						"accessor":    "kv_1234",
+						"secret_name": "b",
+						"sync_status": "SYNCED",
+						"updated_at":  "2026-01-02T00:00:00Z",
+					},
+					"kv_1234/a": map[string]interface{}{
+						"accessor":    "kv_1234",
+						"secret_name": "a",
+						"sync_status": "UNSYNCED",
+						"updated_at":  "2026-01-01T00:00:00Z",
+						"last_error":  "permission denied",
+					},
+				},
+			},
+		})
+	})
+
+	config, ln := testutil.TestHTTPServer(t, handler)
+	defer ln.Close()
+
+	c, err := api.NewClient(config)
+	require.NoError(t, err)
+
+	associations, err := readSecretsSyncAssociations(context.Background(), c, "repo", "gh")
+	require.NoError(t, err)
+	assert.Equal(t, []SecretsSyncAssociationModel{
+		{
+			Accessor:   types.StringValue("kv_1234"),
+			SecretName: types.StringValue("a"),
+			SubKey:     types.StringValue(""),
+			SyncStatus: types.StringValue("UNSYNCED"),
+			UpdatedAt:  types.StringValue("2026-01-01T00:00:00Z"),
+			LastError:  types.StringValue("permission denied"),
+		},
+		{
+			Accessor:   types.StringValue("kv_1234"),
+			SecretName: types.StringValue("b"),
+			SubKey:     types.StringValue(""),
+			SyncStatus: types.StringValue("SYNCED"),
+			UpdatedAt:  types.StringValue("2026-01-02T00:00:00Z"),
+			LastError:  types.StringValue(""),
+		},
+	}, associations)
+
+	associations, err = readSecretsSyncAssociations(context.Background(), c, "missing", "gh")
+	require.NoError(t, err)
+	assert.Empty(t, associations)
+}
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccSecretsSyncDestinationsDataSource(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-sync")
+	destName := acctest.RandomWithPrefix("tf-sync-dest")
+	secretName := acctest.RandomWithPrefix("tf-sync-secret")
+	dataSourceName := "data.vault_secrets_sync_destinations.test"
+
+	values := testutil.SkipTestEnvUnset(t,
+		"GITHUB_ACCESS_TOKEN",
+		"GITHUB_REPO_OWNER",
+		"GITHUB_REPO_NAME",
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctestutil.TestAccPreCheck(t)
+			acctestutil.SkipIfAPIVersionLT(t, provider.VaultVersion116)
+		},
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretsSyncDestinationsDataSourceConfig(mount, destName, secretName, values[0], values[1], values[2]),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, consts.FieldType, "gh"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "destinations.*", map[string]string{
+						consts.FieldName:             destName,
+						consts.FieldType:             "gh",
+						"associations.#":             "1",
+						"associations.0.secret_name": secretName,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccSecretsSyncDestinationsDataSourceConfig(mount, destName, secretName, accessToken, owner, repoName string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path    = "%s"
+  type    = "kv"
+  options = { version = "2" }
+}
+
+resource "vault_kv_secret_v2" "test" {
+  mount     = vault_mount.test.path
+  name      = "%s"
+  data_json = jsonencode({ foo = "bar" })
+}
+
+resource "vault_secrets_sync_gh_destination" "test" {
+  name             = "%s"
+  access_token     = "%s"
+  repository_owner = "%s"
+  repository_name  = "%s"
+  granularity      = "secret-path"
+}
+
+resource "vault_secrets_sync_association" "test" {
+  name        = vault_secrets_sync_gh_destination.test.name
+  type        = vault_secrets_sync_gh_destination.test.type
+  mount       = vault_mount.test.path
+  secret_name = vault_kv_secret_v2.test.name
+}
+
+data "vault_secrets_sync_destinations" "test" {
+  type       = "gh"
+  depends_on = [vault_secrets_sync_association.test]
+}
+`, mount, secretName, destName, accessToken, owner, repoName)
+}
@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+var _ ephemeral.EphemeralResource = &UnwrapEphemeralResource{}
+
+// NewUnwrapEphemeralResource returns the implementation for this resource to be
+// imported by the Terraform Plugin Framework provider
+var NewUnwrapEphemeralResource = func() ephemeral.EphemeralResource {
+	return &UnwrapEphemeralResource{}
+}
+
+// UnwrapEphemeralResource implements the methods that define this resource
+type UnwrapEphemeralResource struct {
+	base.EphemeralResourceWithConfigure
+}
+
+// UnwrapModel describes the Terraform resource data model to match the
+// resource schema.
+type UnwrapModel struct {
+	base.BaseModelEphemeral
+
+	Token          types.String `tfsdk:"token"`
+	Data           types.Map    `tfsdk:"data"`
+	DataJSON       types.String `tfsdk:"data_json"`
+	ClientToken    types.String `tfsdk:"client_token"`
+	Accessor       types.String `tfsdk:"accessor"`
+	LeaseID        types.String `tfsdk:"lease_id"`
+	LeaseDuration  types.Int64  `tfsdk:"lease_duration"`
+	LeaseRenewable types.Bool   `tfsdk:"lease_renewable"`
+}
+
+func (r *UnwrapEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldToken: schema.StringAttribute{
+				MarkdownDescription: "The wrapping token to unwrap. The token can only be unwrapped once.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			consts.FieldData: schema.MapAttribute{
+				MarkdownDescription: "Map of strings of the wrapped response data. Values that are not " +
+					"strings are JSON encoded.",
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			consts.FieldDataJSON: schema.StringAttribute{
+				MarkdownDescription: "JSON-encoded wrapped response data.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			consts.FieldClientToken: schema.StringAttribute{
+				MarkdownDescription: "The client token, if the wrapped response was an auth response.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			consts.FieldAccessor: schema.StringAttribute{
+				MarkdownDescription: "The accessor of the client token, if the wrapped response was an auth response.",
+				Computed:            true,
+			},
+			consts.FieldLeaseID: schema.StringAttribute{
+				MarkdownDescription: "Lease identifier of the wrapped response.",
+				Computed:            true,
+			},
+			consts.FieldLeaseDuration: schema.Int64Attribute{
+				MarkdownDescription: "Lease duration in seconds of the wrapped response.",
+				Computed:            true,
+			},
+			consts.FieldLeaseRenewable: schema.BoolAttribute{
+				MarkdownDescription: "True if the lease of the wrapped response can be renewed.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: "Unwraps a response wrapping token, returning the wrapped response.",
+	}
+
+	base.MustAddBaseEphemeralSchema(&resp.Schema)
+}
+
+func (r *UnwrapEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unwrap"
+}
+
+func (r *UnwrapEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data UnwrapModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, r.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	c, err = data.WrappingClient(c)
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	secret, err := c.Logical().UnwrapWithContext(ctx, data.Token.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error unwrapping token", err.Error())
+		return
+	}
+
+	if data.SetWrapInfo(secret) {
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
+	if secret == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+
+	dataMap := make(map[string]string, len(secret.Data))
+	for k, v := range secret.Data {
+		if vs, ok := v.(string); ok {
+			dataMap[k] = vs
+			continue
+		}
+
+		vBytes, err := json.Marshal(v)
+		if err != nil {
+			resp.Diagnostics.AddError("Error marshalling wrapped value", err.Error())
+			return
+		}
+		dataMap[k] = string(vBytes)
+	}
+
+	mapValue, diags := types.MapValueFrom(ctx, types.StringType, dataMap)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Data = mapValue
+
+	jsonData, err := json.Marshal(secret.Data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error marshalling data to JSON", err.Error())
+		return
+	}
+	data.DataJSON = types.StringValue(string(jsonData))
+
+	data.ClientToken = types.StringNull()
+	data.Accessor = types.StringNull()
+	if secret.Auth != nil {
+		data.ClientToken = types.StringValue(secret.Auth.ClientToken)
+		data.Accessor = types.StringValue(secret.Auth.Accessor)
+	}
+
+	data.LeaseID = types.StringValue(secret.LeaseID)
+	data.LeaseDuration = types.Int64Value(int64(secret.LeaseDuration))
+	data.LeaseRenewable = types.BoolValue(secret.Renewable)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
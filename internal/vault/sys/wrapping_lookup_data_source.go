@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/base"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/client"
+	"github.com/hashicorp/terraform-provider-vault/internal/framework/errutil"
+)
+
+const wrappingLookupPath = "sys/wrapping/lookup"
+
+var _ datasource.DataSource = &WrappingLookupDataSource{}
+
+// NewWrappingLookupDataSource returns the implementation for this data source
+func NewWrappingLookupDataSource() datasource.DataSource {
+	return &WrappingLookupDataSource{}
+}
+
+// WrappingLookupDataSource implements the methods that define this data source
+type WrappingLookupDataSource struct {
+	base.DataSourceWithConfigure
+}
+
+// WrappingLookupModel describes the Terraform data source data model
+type WrappingLookupModel struct {
+	base.BaseModel
+
+	Token        types.String `tfsdk:"token"`
+	CreationPath types.String `tfsdk:"creation_path"`
+	CreationTime types.String `tfsdk:"creation_time"`
+	CreationTTL  types.Int64  `tfsdk:"creation_ttl"`
+}
+
+func (d *WrappingLookupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wrapping_lookup"
+}
+
+func (d *WrappingLookupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			consts.FieldNamespace: schema.StringAttribute{
+				MarkdownDescription: "Target namespace.",
+				Optional:            true,
+			},
+			consts.FieldToken: schema.StringAttribute{
+				MarkdownDescription: "The wrapping token to look up. Looking up a token does not unwrap it.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			consts.FieldCreationPath: schema.StringAttribute{
+				MarkdownDescription: "The API path of the request whose response was wrapped.",
+				Computed:            true,
+			},
+			consts.FieldCreationTime: schema.StringAttribute{
+				MarkdownDescription: "The time the wrapping token was created.",
+				Computed:            true,
+			},
+			consts.FieldCreationTTL: schema.Int64Attribute{
+				MarkdownDescription: "The TTL in seconds the wrapping token was created with.",
+				Computed:            true,
+			},
+		},
+		MarkdownDescription: "Looks up the properties of a response wrapping token without unwrapping it.",
+	}
+}
+
+func (d *WrappingLookupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WrappingLookupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.GetClient(ctx, d.Meta(), data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(errutil.ClientConfigureErr(err))
+		return
+	}
+
+	secret, err := c.Logical().WriteWithContext(ctx, wrappingLookupPath, map[string]interface{}{
+		consts.FieldToken: data.Token.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error looking up wrapping token", err.Error())
+		return
+	}
+
+	if secret == nil {
+		resp.Diagnostics.AddError(errutil.VaultReadResponseNil())
+		return
+	}
+
+	data.CreationPath = types.StringNull()
+	if v, ok := secret.Data[consts.FieldCreationPath].(string); ok {
+		data.CreationPath = types.StringValue(v)
+	}
+
+	data.CreationTime = types.StringNull()
+	if v, ok := secret.Data[consts.FieldCreationTime].(string); ok {
+		data.CreationTime = types.StringValue(v)
+	}
+
+	data.CreationTTL = types.Int64Null()
+	if v, ok := secret.Data[consts.FieldCreationTTL].(json.Number); ok {
+		ttl, err := v.Int64()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unexpected API response",
+				fmt.Sprintf("error parsing %q: %s", consts.FieldCreationTTL, err),
+			)
+			return
+		}
+		data.CreationTTL = types.Int64Value(ttl)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sys_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/providertest"
+)
+
+func TestAccUnwrapEphemeralResource(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-kv")
+	path := acctest.RandomWithPrefix("secret")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctestutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUnwrapConfigSetup(mount, path),
+			},
+			{
+				Config: testAccUnwrapConfig(mount, path),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test",
+						tfjsonpath.New("data").AtMapKey("wrap_info").AtMapKey(consts.FieldCreationPath),
+						knownvalue.StringExact(fmt.Sprintf("%s/%s", mount, path))),
+					statecheck.ExpectKnownValue("echo.test",
+						tfjsonpath.New("data").AtMapKey("wrap_info").AtMapKey(consts.FieldTTL),
+						knownvalue.Int64Exact(300)),
+					statecheck.ExpectKnownValue("echo.test",
+						tfjsonpath.New("data").AtMapKey("secret_data"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("echo.test",
+						tfjsonpath.New("data").AtMapKey("unwrapped").AtMapKey("username"),
+						knownvalue.StringExact("admin")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccWrappingLookupDataSource(t *testing.T) {
+	dataSourceName := "data.vault_wrapping_lookup.test"
+
+	var token string
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctestutil.TestAccPreCheck(t)
+			token = testAccWrapData(t)
+		},
+		ProtoV5ProviderFactories: providertest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "vault_wrapping_lookup" "test" {
+  token = "%s"
+}
+`, token),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, consts.FieldCreationPath, "sys/wrapping/wrap"),
+					resource.TestCheckResourceAttr(dataSourceName, consts.FieldCreationTTL, "300"),
+					resource.TestCheckResourceAttrSet(dataSourceName, consts.FieldCreationTime),
+				),
+			},
+			{
+				Config: `
+data "vault_wrapping_lookup" "test" {
+  token = "invalid"
+}
+`,
+				ExpectError: regexp.MustCompile("Error looking up wrapping token"),
+			},
+		},
+	})
+}
+
+// testAccWrapData wraps arbitrary data and returns the wrapping token.
+func testAccWrapData(t *testing.T) string {
+	t.Helper()
+
+	client, err := api.NewClient(api.DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client.SetWrappingLookupFunc(func(_, _ string) string {
+		return "5m"
+	})
+
+	secret, err := client.Logical().Write("sys/wrapping/wrap", map[string]interface{}{
+		"foo": "bar",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if secret == nil || secret.WrapInfo == nil {
+		t.Fatal("expected a wrapped response")
+	}
+
+	return secret.WrapInfo.Token
+}
+
+func testAccUnwrapConfigSetup(mount, path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kv" {
+  path = "%s"
+  type = "kv"
+  options = {
+    version = "1"
+  }
+}
+
+resource "vault_generic_secret" "test" {
+  path = "${vault_mount.kv.path}/%s"
+  data_json = jsonencode({
+    username = "admin"
+  })
+}
+`, mount, path)
+}
+
+func testAccUnwrapConfig(mount, path string) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "vault_generic_secret" "test" {
+  path     = vault_generic_secret.test.path
+  wrap_ttl = "5m"
+}
+
+ephemeral "vault_unwrap" "test" {
+  token = ephemeral.vault_generic_secret.test.wrap_info.token
+}
+
+provider "echo" {
+  data = {
+    wrap_info   = ephemeral.vault_generic_secret.test.wrap_info
+    secret_data = ephemeral.vault_generic_secret.test.data
+    unwrapped   = ephemeral.vault_unwrap.test.data
+  }
+}
+
+resource "echo" "test" {}
+`, testAccUnwrapConfigSetup(mount, path))
+}
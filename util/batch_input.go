@@ -1,7 +1,7 @@
 // Copyright IBM Corp. 2016, 2026
 // SPDX-License-Identifier: MPL-2.0
 
-package vault
+package util
 
 import (
 	"fmt"
@@ -11,9 +11,9 @@ import (
 
 // When batch_input is provided as a map, all of the fields get parsed as strings,
 // which results in an error if non-string parameters are included, because Vault
-// expects a different type. convertBatchInput converts these values to their correct
+// expects a different type. ConvertBatchInput converts these values to their correct
 // types to avoid this error
-func convertBatchInput(batchInput interface{}, intFields []string) ([]map[string]interface{}, error) {
+func ConvertBatchInput(batchInput interface{}, intFields []string) ([]map[string]interface{}, error) {
 	convertedBatchInput := make([]map[string]interface{}, 0)
 
 	inputList, ok := batchInput.([]interface{})
@@ -49,7 +49,7 @@ func convertBatchInput(batchInput interface{}, intFields []string) ([]map[string
 
 // The code that does the parsing for maps will panic if given a map with a mix of boolean
 // and string values. This function converts booleans to strings to avoid the error.
-func convertBatchResults(rawResults interface{}) ([]map[string]interface{}, error) {
+func ConvertBatchResults(rawResults interface{}) ([]map[string]interface{}, error) {
 	batchResultsList, ok := rawResults.([]interface{})
 	if !ok {
 		return nil, fmt.Errorf("unexpected batch_results type %T", rawResults)
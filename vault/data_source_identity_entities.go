@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/identity/entity"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const (
+	fieldAliasNames     = "alias_names"
+	fieldMountAccessors = "mount_accessors"
+	fieldEntities       = "entities"
+	fieldEntityIDs      = "entity_ids"
+)
+
+// identityEntityAliasMatch is an entity found by the name of one of its
+// aliases.
+type identityEntityAliasMatch struct {
+	AliasName     string
+	MountAccessor string
+	EntityID      string
+	EntityName    string
+}
+
+func identityEntitiesDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: provider.ReadContextWrapper(identityEntitiesDataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			fieldAliasNames: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the aliases to look up.",
+			},
+			fieldMountAccessors: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Accessors of the mounts in which each alias name is looked up.",
+			},
+			fieldEntities: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: "The entities found for each alias name and mount accessor, " +
+					"sorted by alias name and mount accessor.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the alias.",
+						},
+						consts.FieldMountAccessor: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Accessor of the mount of the alias.",
+						},
+						consts.FieldEntityID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the entity of the alias.",
+						},
+						"entity_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the entity of the alias.",
+						},
+					},
+				},
+			},
+			fieldEntityIDs: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the entities found, without duplicates.",
+			},
+		},
+	}
+}
+
+func identityEntitiesDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	names := expandStringSlice(d.Get(fieldAliasNames).(*schema.Set).List())
+	accessors := expandStringSlice(d.Get(fieldMountAccessors).(*schema.Set).List())
+
+	matches, err := lookupIdentityEntitiesByAlias(ctx, client, names, accessors)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	entities := make([]map[string]interface{}, 0, len(matches))
+	entityIDs := make([]string, 0, len(matches))
+	for _, m := range matches {
+		entities = append(entities, map[string]interface{}{
+			"alias_name":              m.AliasName,
+			consts.FieldMountAccessor: m.MountAccessor,
+			consts.FieldEntityID:      m.EntityID,
+			"entity_name":             m.EntityName,
+		})
+		entityIDs = append(entityIDs, m.EntityID)
+	}
+
+	d.SetId(entity.LookupPath)
+	if err := d.Set(fieldEntities, entities); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(fieldEntityIDs, entityIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// lookupIdentityEntitiesByAlias looks up the entity of each alias name in
+// each mount. Aliases that do not exist are skipped.
+func lookupIdentityEntitiesByAlias(ctx context.Context, client *api.Client, names, accessors []string) ([]identityEntityAliasMatch, error) {
+	sort.Strings(names)
+	sort.Strings(accessors)
+
+	var result []identityEntityAliasMatch
+	for _, name := range names {
+		for _, accessor := range accessors {
+			log.Printf("[DEBUG] Looking up IdentityEntity of alias %q in mount %q", name, accessor)
+			resp, err := client.Logical().WriteWithContext(ctx, entity.LookupPath, map[string]interface{}{
+				"alias_name":           name,
+				"alias_mount_accessor": accessor,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error looking up IdentityEntity of alias %q in mount %q: %w", name, accessor, err)
+			}
+			if resp == nil || resp.Data == nil {
+				continue
+			}
+
+			id, _ := resp.Data["id"].(string)
+			if id == "" {
+				continue
+			}
+			entityName, _ := resp.Data["name"].(string)
+
+			result = append(result, identityEntityAliasMatch{
+				AliasName:     name,
+				MountAccessor: accessor,
+				EntityID:      id,
+				EntityName:    entityName,
+			})
+		}
+	}
+
+	return result, nil
+}
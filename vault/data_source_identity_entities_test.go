@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccDataSourceIdentityEntities(t *testing.T) {
+	name := acctest.RandomWithPrefix("entity")
+	ds := "data.vault_identity_entities.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIdentityEntitiesConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(ds, fieldEntities+".#", "2"),
+					resource.TestCheckResourceAttr(ds, fieldEntities+".0.alias_name", name+"-a"),
+					resource.TestCheckResourceAttrPair(ds, fieldEntities+".0."+consts.FieldEntityID, "vault_identity_entity.a", consts.FieldID),
+					resource.TestCheckResourceAttr(ds, fieldEntities+".0.entity_name", name+"-a"),
+					resource.TestCheckResourceAttr(ds, fieldEntities+".1.alias_name", name+"-b"),
+					resource.TestCheckResourceAttrPair(ds, fieldEntities+".1."+consts.FieldMountAccessor, "vault_auth_backend.b", consts.FieldAccessor),
+					resource.TestCheckResourceAttrPair(ds, fieldEntities+".1."+consts.FieldEntityID, "vault_identity_entity.b", consts.FieldID),
+					resource.TestCheckResourceAttr(ds, fieldEntityIDs+".#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceIdentityEntitiesConfig(name string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "a" {
+  type = "userpass"
+  path = "%[1]s-a"
+}
+
+resource "vault_auth_backend" "b" {
+  type = "userpass"
+  path = "%[1]s-b"
+}
+
+resource "vault_identity_entity" "a" {
+  name = "%[1]s-a"
+}
+
+resource "vault_identity_entity" "b" {
+  name = "%[1]s-b"
+}
+
+resource "vault_identity_entity_alias" "a" {
+  name           = "%[1]s-a"
+  mount_accessor = vault_auth_backend.a.accessor
+  canonical_id   = vault_identity_entity.a.id
+}
+
+resource "vault_identity_entity_alias" "b" {
+  name           = "%[1]s-b"
+  mount_accessor = vault_auth_backend.b.accessor
+  canonical_id   = vault_identity_entity.b.id
+}
+
+data "vault_identity_entities" "test" {
+  alias_names = [
+    vault_identity_entity_alias.a.name,
+    vault_identity_entity_alias.b.name,
+    "%[1]s-missing",
+  ]
+  mount_accessors = [
+    vault_auth_backend.a.accessor,
+    vault_auth_backend.b.accessor,
+  ]
+}
+`, name)
+}
+
+func TestLookupIdentityEntitiesByAlias(t *testing.T) {
+	entities := map[string]map[string]interface{}{
+		"alice/acc-1": {"id": "id-1", "name": "alice"},
+		"bob/acc-2":   {"id": "id-2", "name": "bob"},
+		"carol/acc-1": {"id": "id-1", "name": "alice"},
+	}
+
+	var lookups []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/v1/identity/lookup/entity" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		key := body["alias_name"] + "/" + body["alias_mount_accessor"]
+		lookups = append(lookups, key)
+
+		data, ok := entities[key]
+		if !ok {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	})
+
+	config, ln := testutil.TestHTTPServer(t, handler)
+	defer ln.Close()
+
+	client, err := api.NewClient(config)
+	require.NoError(t, err)
+
+	got, err := lookupIdentityEntitiesByAlias(context.Background(), client,
+		[]string{"carol", "bob", "alice"}, []string{"acc-2", "acc-1"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"alice/acc-1", "alice/acc-2",
+		"bob/acc-1", "bob/acc-2",
+		"carol/acc-1", "carol/acc-2",
+	}, lookups)
+	assert.Equal(t, []identityEntityAliasMatch{
+		{AliasName: "alice", MountAccessor: "acc-1", EntityID: "id-1", EntityName: "alice"},
+		{AliasName: "bob", MountAccessor: "acc-2", EntityID: "id-2", EntityName: "bob"},
+		{AliasName: "carol", MountAccessor: "acc-1", EntityID: "id-1", EntityName: "alice"},
+	}, got)
+}
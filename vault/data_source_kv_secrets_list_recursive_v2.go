@@ -0,0 +1,358 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+func kvSecretListRecursiveDataSourceV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: provider.ReadContextWrapper(kvSecretListRecursiveDataSourceV2Read),
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldMount: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path where KV-V2 engine is mounted.",
+			},
+			consts.FieldName: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Full named path under which the secrets are listed. " +
+					"All the secrets of the mount are listed if not set.",
+			},
+			consts.FieldMaxDepth: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "The maximum number of path levels below name to list. " +
+					"A depth of 1 only lists the direct children of name. Unlimited if not set.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			consts.FieldInclude: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Glob patterns matched against the secret names, only the secrets " +
+					"that match at least one of the patterns are returned.",
+			},
+			consts.FieldExclude: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Glob patterns matched against the secret names, the secrets that match are not returned.",
+			},
+			consts.FieldMaxConcurrency: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				Description:  "The maximum number of requests that are sent to Vault at the same time.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			consts.FieldPath: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path where the KV-V2 secrets are listed.",
+			},
+			consts.FieldNames: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The sorted names of the secrets, relative to the mount.",
+			},
+			consts.FieldSecrets: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The metadata of the secrets, in the same order as names.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Full name of the secret, relative to the mount.",
+						},
+						consts.FieldCurrentVersion: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The current version of the secret.",
+						},
+						consts.FieldCreatedTime: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time at which the secret was created.",
+						},
+						consts.FieldUpdatedTime: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time at which the secret was last updated.",
+						},
+						consts.FieldCustomMetadata: {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Custom metadata of the secret.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func kvSecretListRecursiveDataSourceV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	mount := strings.Trim(d.Get(consts.FieldMount).(string), "/")
+	prefix := strings.Trim(d.Get(consts.FieldName).(string), "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+
+	matcher, err := newKVV2NameMatcher(
+		expandStringSlice(d.Get(consts.FieldInclude).([]interface{})),
+		expandStringSlice(d.Get(consts.FieldExclude).([]interface{})),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	w := &kvV2TreeWalker{
+		client:   client,
+		mount:    mount,
+		maxDepth: d.Get(consts.FieldMaxDepth).(int),
+		match:    matcher,
+		sem:      make(chan struct{}, d.Get(consts.FieldMaxConcurrency).(int)),
+	}
+
+	names, err := w.list(prefix)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secrets, err := w.readMetadata(ctx, names)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	names = make([]string, 0, len(secrets))
+	for _, s := range secrets {
+		names = append(names, s[consts.FieldName].(string))
+	}
+
+	path := getKVV2Path(mount, prefix, consts.FieldMetadata)
+	if err := d.Set(consts.FieldPath, path); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(consts.FieldNames, names); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(consts.FieldSecrets, secrets); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(path)
+
+	return nil
+}
+
+// newKVV2NameMatcher returns a function that reports whether a secret name
+// matches one of the include patterns, or all names if there are none, and
+// none of the exclude patterns. The patterns use the syntax of path.Match.
+func newKVV2NameMatcher(include, exclude []string) (func(string) bool, error) {
+	for _, p := range append(include, exclude...) {
+		if _, err := path.Match(p, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", p, err)
+		}
+	}
+
+	matchAny := func(patterns []string, name string) bool {
+		for _, p := range patterns {
+			if ok, _ := path.Match(p, name); ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(name string) bool {
+		if len(include) > 0 && !matchAny(include, name) {
+			return false
+		}
+		return !matchAny(exclude, name)
+	}, nil
+}
+
+// kvV2TreeWalker lists the secrets of a KV-V2 mount recursively, with at most
+// cap(sem) requests in flight.
+type kvV2TreeWalker struct {
+	client   *api.Client
+	mount    string
+	maxDepth int
+	match    func(string) bool
+	sem      chan struct{}
+
+	wg    sync.WaitGroup
+	m     sync.Mutex
+	names []string
+	err   error
+}
+
+// list returns the sorted names of the secrets under prefix that are matched.
+func (w *kvV2TreeWalker) list(prefix string) ([]string, error) {
+	w.wg.Add(1)
+	go w.walk(prefix, 1)
+	w.wg.Wait()
+
+	if w.err != nil {
+		return nil, w.err
+	}
+
+	sort.Strings(w.names)
+	return w.names, nil
+}
+
+func (w *kvV2TreeWalker) walk(dir string, depth int) {
+	defer w.wg.Done()
+
+	w.sem <- struct{}{}
+	keys, err := w.listKeys(dir)
+	<-w.sem
+
+	w.m.Lock()
+	defer w.m.Unlock()
+	if err != nil {
+		if w.err == nil {
+			w.err = err
+		}
+		return
+	}
+
+	for _, k := range keys {
+		name := dir + k
+		if strings.HasSuffix(name, "/") {
+			if w.maxDepth == 0 || depth < w.maxDepth {
+				w.wg.Add(1)
+				go w.walk(name, depth+1)
+			}
+			continue
+		}
+
+		if w.match(name) {
+			w.names = append(w.names, name)
+		}
+	}
+}
+
+func (w *kvV2TreeWalker) listKeys(dir string) ([]string, error) {
+	w.m.Lock()
+	failed := w.err != nil
+	w.m.Unlock()
+	if failed {
+		return nil, nil
+	}
+
+	listPath := getKVV2Path(w.mount, dir, consts.FieldMetadata)
+	log.Printf("[DEBUG] Listing secrets at %s from Vault", listPath)
+	resp, err := w.client.Logical().List(listPath)
+	if err != nil {
+		return nil, fmt.Errorf("error listing from Vault at path %q, err=%s", listPath, err)
+	}
+
+	// Vault does not return a response for empty or missing directories,
+	// e.g. when their last secret was deleted after the parent was listed.
+	if resp == nil {
+		return nil, nil
+	}
+
+	keys, ok := resp.Data["keys"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("keys are incorrectly formatted in response from Vault")
+	}
+
+	return expandStringSlice(keys), nil
+}
+
+// readMetadata reads the metadata of each secret. Secrets that were deleted
+// after they were listed are skipped.
+func (w *kvV2TreeWalker) readMetadata(ctx context.Context, names []string) ([]map[string]interface{}, error) {
+	results := make([]map[string]interface{}, len(names))
+	errs := make([]error, len(names))
+
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		w.sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-w.sem
+				wg.Done()
+			}()
+
+			metadataPath := getKVV2Path(w.mount, name, consts.FieldMetadata)
+			log.Printf("[DEBUG] Reading metadata from %q", metadataPath)
+			resp, err := w.client.Logical().ReadWithContext(ctx, metadataPath)
+			if err != nil {
+				errs[i] = fmt.Errorf("error reading from %q: %s", metadataPath, err)
+				return
+			}
+			if resp != nil {
+				results[i] = kvV2SecretMetadata(name, resp.Data)
+			}
+		}()
+	}
+	wg.Wait()
+
+	secrets := make([]map[string]interface{}, 0, len(names))
+	for i := range names {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		if results[i] != nil {
+			secrets = append(secrets, results[i])
+		}
+	}
+
+	return secrets, nil
+}
+
+// kvV2SecretMetadata flattens the response of the KV-V2 metadata endpoint.
+func kvV2SecretMetadata(name string, data map[string]interface{}) map[string]interface{} {
+	m := map[string]interface{}{
+		consts.FieldName: name,
+	}
+
+	if v, ok := data[consts.FieldCurrentVersion].(json.Number); ok {
+		n, _ := v.Int64()
+		m[consts.FieldCurrentVersion] = int(n)
+	}
+	if v, ok := data[consts.FieldCreatedTime].(string); ok {
+		m[consts.FieldCreatedTime] = v
+	}
+	if v, ok := data[consts.FieldUpdatedTime].(string); ok {
+		m[consts.FieldUpdatedTime] = v
+	}
+	if v, ok := data[consts.FieldCustomMetadata].(map[string]interface{}); ok {
+		m[consts.FieldCustomMetadata] = serializeDataMapToString(v)
+	}
+
+	return m
+}
@@ -0,0 +1,234 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestKVV2NameMatcher(t *testing.T) {
+	match, err := newKVV2NameMatcher([]string{"app/*", "db/*/creds"}, []string{"app/tmp-*"})
+	require.NoError(t, err)
+
+	assert.True(t, match("app/config"))
+	assert.True(t, match("db/prod/creds"))
+	assert.False(t, match("app/tmp-1"))
+	assert.False(t, match("app/nested/config"))
+	assert.False(t, match("other"))
+
+	match, err = newKVV2NameMatcher(nil, []string{"*/tmp"})
+	require.NoError(t, err)
+	assert.True(t, match("app"))
+	assert.False(t, match("app/tmp"))
+
+	_, err = newKVV2NameMatcher([]string{"["}, nil)
+	assert.Error(t, err)
+}
+
+func TestKVV2TreeWalker(t *testing.T) {
+	tree := map[string][]string{
+		// gone/ is no longer found when it is listed
+		"":            {"top", "app/", "gone/"},
+		"app/":        {"config", "nested/"},
+		"app/nested/": {"deep"},
+	}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		name := strings.TrimPrefix(r.URL.Path, "/v1/kv/metadata")
+		name = strings.TrimPrefix(name, "/")
+		if r.URL.Query().Get("list") == "true" {
+			if name != "" {
+				name += "/"
+			}
+			keys, ok := tree[name]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": map[string]interface{}{"keys": keys},
+			})
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				consts.FieldCurrentVersion: 3,
+				consts.FieldCreatedTime:    "2026-01-01T00:00:00Z",
+				consts.FieldUpdatedTime:    "2026-02-01T00:00:00Z",
+				consts.FieldCustomMetadata: map[string]interface{}{"owner": name},
+			},
+		})
+	})
+
+	config, ln := testutil.TestHTTPServer(t, handler)
+	defer ln.Close()
+
+	client, err := api.NewClient(config)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		prefix   string
+		maxDepth int
+		want     []string
+	}{
+		{
+			name: "all",
+			want: []string{"app/config", "app/nested/deep", "top"},
+		},
+		{
+			name:     "max-depth",
+			maxDepth: 2,
+			want:     []string{"app/config", "top"},
+		},
+		{
+			name:   "prefix",
+			prefix: "app/",
+			want:   []string{"app/config", "app/nested/deep"},
+		},
+		{
+			name:   "missing-prefix",
+			prefix: "missing/",
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &kvV2TreeWalker{
+				client:   client,
+				mount:    "kv",
+				maxDepth: tt.maxDepth,
+				match:    func(string) bool { return true },
+				sem:      make(chan struct{}, 2),
+			}
+
+			names, err := w.list(tt.prefix)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, names)
+		})
+	}
+
+	w := &kvV2TreeWalker{
+		client: client,
+		mount:  "kv",
+		sem:    make(chan struct{}, 2),
+	}
+	secrets, err := w.readMetadata(context.Background(), []string{"top"})
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{
+		{
+			consts.FieldName:           "top",
+			consts.FieldCurrentVersion: 3,
+			consts.FieldCreatedTime:    "2026-01-01T00:00:00Z",
+			consts.FieldUpdatedTime:    "2026-02-01T00:00:00Z",
+			consts.FieldCustomMetadata: map[string]string{"owner": "top"},
+		},
+	}, secrets)
+}
+
+func TestDataSourceKVSecretListRecursiveV2(t *testing.T) {
+	t.Parallel()
+	mount := acctest.RandomWithPrefix("tf-kv")
+	datasource := "data.vault_kv_secrets_list_recursive_v2.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKVV2SecretListRecursiveConfig(mount, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasource, consts.FieldPath, fmt.Sprintf("%s/metadata/", mount)),
+					resource.TestCheckResourceAttr(datasource, "names.#", "3"),
+					resource.TestCheckResourceAttr(datasource, "names.0", "app/config"),
+					resource.TestCheckResourceAttr(datasource, "names.1", "app/nested/deep"),
+					resource.TestCheckResourceAttr(datasource, "names.2", "top"),
+					resource.TestCheckResourceAttr(datasource, "secrets.#", "3"),
+					resource.TestCheckResourceAttr(datasource, "secrets.0.name", "app/config"),
+					resource.TestCheckResourceAttr(datasource, "secrets.0.current_version", "1"),
+					resource.TestCheckResourceAttr(datasource, "secrets.0.custom_metadata.owner", "team-a"),
+					resource.TestCheckResourceAttrSet(datasource, "secrets.0.created_time"),
+					resource.TestCheckResourceAttrSet(datasource, "secrets.0.updated_time"),
+				),
+			},
+			{
+				Config: testDataSourceKVV2SecretListRecursiveConfig(mount, `
+  name      = "app"
+  max_depth = 1
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasource, consts.FieldPath, fmt.Sprintf("%s/metadata/app/", mount)),
+					resource.TestCheckResourceAttr(datasource, "names.#", "1"),
+					resource.TestCheckResourceAttr(datasource, "names.0", "app/config"),
+				),
+			},
+			{
+				Config: testDataSourceKVV2SecretListRecursiveConfig(mount, `
+  include = ["app/*/*", "top"]
+  exclude = ["top"]
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasource, "names.#", "1"),
+					resource.TestCheckResourceAttr(datasource, "names.0", "app/nested/deep"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKVV2SecretListRecursiveConfig(mount, args string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_kv_secret_v2" "top" {
+  mount     = vault_mount.kvv2.path
+  name      = "top"
+  data_json = jsonencode({ foo = "bar" })
+}
+
+resource "vault_kv_secret_v2" "config" {
+  mount     = vault_mount.kvv2.path
+  name      = "app/config"
+  data_json = jsonencode({ foo = "bar" })
+  custom_metadata {
+    data = {
+      owner = "team-a"
+    }
+  }
+}
+
+resource "vault_kv_secret_v2" "deep" {
+  mount     = vault_mount.kvv2.path
+  name      = "app/nested/deep"
+  data_json = jsonencode({ foo = "bar" })
+}
+
+data "vault_kv_secrets_list_recursive_v2" "test" {
+  mount = vault_mount.kvv2.path
+%s
+  depends_on = [
+    vault_kv_secret_v2.top,
+    vault_kv_secret_v2.config,
+    vault_kv_secret_v2.deep,
+  ]
+}
+`, kvV2MountConfig(mount), args)
+}
@@ -6,6 +6,7 @@ package vault
 import (
 	"context"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,6 +29,37 @@ func namespacesDataSource() *schema.Resource {
 				Default:     false,
 				Description: "True to fetch all child namespaces.",
 			},
+			consts.FieldNamespaces: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: "The child namespaces, sorted by path. Includes the whole " +
+					"namespace tree if recursive is true.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldPath: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Namespace path, relative to the namespace of the data source.",
+						},
+						consts.FieldPathFQ: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The fully qualified namespace path.",
+						},
+						consts.FieldNamespaceID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Namespace ID.",
+						},
+						consts.FieldCustomMetadata: {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Metadata associated with the namespace.",
+						},
+					},
+				},
+			},
 			consts.FieldPaths: {
 				Type:        schema.TypeSet,
 				Computed:    true,
@@ -44,8 +76,15 @@ func namespacesDataSource() *schema.Resource {
 	}
 }
 
-func namespacesReadNamespacePaths(ctx context.Context, client *api.Client, namespace string, recursive bool) ([]string, diag.Diagnostics) {
-	var allNamespaces []string
+// namespaceEntry describes a namespace returned by a LIST on sys/namespaces.
+type namespaceEntry struct {
+	pathFQ         string
+	id             string
+	customMetadata map[string]string
+}
+
+func namespacesReadNamespaces(ctx context.Context, client *api.Client, namespace string, recursive bool) ([]namespaceEntry, diag.Diagnostics) {
+	var allNamespaces []namespaceEntry
 
 	client.SetNamespace(namespace)
 
@@ -59,11 +98,27 @@ func namespacesReadNamespacePaths(ctx context.Context, client *api.Client, names
 		prefix = namespace + "/"
 	}
 
+	keyInfo := map[string]interface{}{}
+	if resp != nil {
+		if v, ok := resp.Data["key_info"].(map[string]interface{}); ok {
+			keyInfo = v
+		}
+	}
+
 	for _, ns := range flattenPaths(resp) {
-		allNamespaces = append(allNamespaces, prefix+ns)
+		entry := namespaceEntry{
+			pathFQ: prefix + ns,
+		}
+		if info, ok := keyInfo[ns+"/"].(map[string]interface{}); ok {
+			entry.id, _ = info["id"].(string)
+			if v, ok := info[consts.FieldCustomMetadata].(map[string]interface{}); ok {
+				entry.customMetadata = serializeDataMapToString(v)
+			}
+		}
+		allNamespaces = append(allNamespaces, entry)
 
 		if recursive {
-			subNamespaces, diags := namespacesReadNamespacePaths(ctx, client, prefix+ns, true)
+			subNamespaces, diags := namespacesReadNamespaces(ctx, client, prefix+ns, true)
 			if diags.HasError() {
 				return nil, diags
 			}
@@ -86,11 +141,31 @@ func namespacesDataSourceRead(ctx context.Context, d *schema.ResourceData, meta
 
 	log.Printf("[DEBUG] Reading namespaces from Vault")
 
-	absolutePaths, diags := namespacesReadNamespacePaths(ctx, client, namespace, d.Get("recursive").(bool))
+	entries, diags := namespacesReadNamespaces(ctx, client, namespace, d.Get("recursive").(bool))
 	if diags.HasError() {
 		return diags
 	}
 
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].pathFQ < entries[j].pathFQ
+	})
+
+	var absolutePaths []string
+	namespaces := make([]map[string]interface{}, 0, len(entries))
+	for _, e := range entries {
+		absolutePaths = append(absolutePaths, e.pathFQ)
+		namespaces = append(namespaces, map[string]interface{}{
+			consts.FieldPath:           strings.TrimPrefix(e.pathFQ, namespace+"/"),
+			consts.FieldPathFQ:         e.pathFQ,
+			consts.FieldNamespaceID:    e.id,
+			consts.FieldCustomMetadata: e.customMetadata,
+		})
+	}
+
+	if err := d.Set(consts.FieldNamespaces, namespaces); err != nil {
+		return diag.Errorf("error setting %q to state: %v", consts.FieldNamespaces, err)
+	}
+
 	if err := d.Set(consts.FieldPathsFQ, absolutePaths); err != nil {
 		return diag.Errorf("error setting %q to state: %v", consts.FieldPathsFQ, err)
 	}
@@ -5,11 +5,16 @@ package vault
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/testutil"
@@ -73,6 +78,11 @@ func TestAccDataSourceNamespaces(t *testing.T) {
 					resource.TestCheckTypeSetElemAttr(resourceName+".test_level0_recursive", consts.FieldPathsFQ+".*", ns+"/level1-ns-0/level2-ns-0"),
 					resource.TestCheckTypeSetElemAttr(resourceName+".test_level0_recursive", consts.FieldPathsFQ+".*", ns+"/level1-ns-1"),
 					resource.TestCheckTypeSetElemAttr(resourceName+".test_level0_recursive", consts.FieldPathsFQ+".*", ns+"/level1-ns-2"),
+					resource.TestCheckResourceAttr(resourceName+".test_level0_recursive", consts.FieldNamespaces+".#", "4"),
+					resource.TestCheckResourceAttr(resourceName+".test_level0_recursive", "namespaces.1.path", "level1-ns-0/level2-ns-0"),
+					resource.TestCheckResourceAttr(resourceName+".test_level0_recursive", "namespaces.1.path_fq", ns+"/level1-ns-0/level2-ns-0"),
+					resource.TestCheckResourceAttr(resourceName+".test_level0_recursive", "namespaces.1.custom_metadata.team", "level2"),
+					resource.TestCheckResourceAttrSet(resourceName+".test_level0_recursive", "namespaces.1.namespace_id"),
 
 					resource.TestCheckResourceAttr(resourceName+".test_level1_recursive", "recursive", "true"),
 					resource.TestCheckResourceAttr(resourceName+".test_level1_recursive", consts.FieldNamespace, ns+"/level1-ns-0"),
@@ -107,6 +117,9 @@ resource "vault_namespace" "level1" {
 resource "vault_namespace" "level2" {
   namespace = vault_namespace.level1[0].path_fq
   path      = "level2-ns-0"
+  custom_metadata = {
+    team = "level2"
+  }
 }
 
 data "vault_namespaces" "test_root" {
@@ -154,3 +167,59 @@ data "vault_namespaces" "test_level2_recursive" {
 
 	return config
 }
+
+func TestNamespacesReadNamespaces(t *testing.T) {
+	tree := map[string]map[string]interface{}{
+		"tenant": {
+			"a/": map[string]interface{}{"id": "id-a", "path": "tenant/a/", "custom_metadata": map[string]interface{}{"team": "a"}},
+			"b/": map[string]interface{}{"id": "id-b", "path": "tenant/b/", "custom_metadata": map[string]interface{}{}},
+		},
+		"tenant/a": {
+			"c/": map[string]interface{}{"id": "id-c", "path": "tenant/a/c/"},
+		},
+	}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keyInfo, ok := tree[r.Header.Get("X-Vault-Namespace")]
+		if !ok || r.URL.Path != "/v1/sys/namespaces" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		keys := make([]string, 0, len(keyInfo))
+		for k := range keyInfo {
+			keys = append(keys, k)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"keys":     keys,
+				"key_info": keyInfo,
+			},
+		})
+	})
+
+	config, ln := testutil.TestHTTPServer(t, handler)
+	defer ln.Close()
+
+	client, err := api.NewClient(config)
+	require.NoError(t, err)
+
+	entries, diags := namespacesReadNamespaces(context.Background(), client, "tenant", true)
+	require.False(t, diags.HasError())
+
+	byPath := make(map[string]namespaceEntry)
+	for _, e := range entries {
+		byPath[e.pathFQ] = e
+	}
+	assert.Equal(t, map[string]namespaceEntry{
+		"tenant/a":   {pathFQ: "tenant/a", id: "id-a", customMetadata: map[string]string{"team": "a"}},
+		"tenant/a/c": {pathFQ: "tenant/a/c", id: "id-c"},
+		"tenant/b":   {pathFQ: "tenant/b", id: "id-b", customMetadata: map[string]string{}},
+	}, byPath)
+
+	entries, diags = namespacesReadNamespaces(context.Background(), client, "tenant", false)
+	require.False(t, diags.HasError())
+	assert.Len(t, entries, 2)
+}
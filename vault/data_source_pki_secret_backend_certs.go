@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/pki"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const (
+	fieldRevokedOnly    = "revoked_only"
+	fieldSerialNumbers  = "serial_numbers"
+	fieldCerts          = "certs"
+	fieldRevoked        = "revoked"
+	fieldRevocationTime = "revocation_time"
+)
+
+func pkiSecretBackendCertsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: provider.ReadContextWrapper(readPKISecretBackendCerts),
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Full path where PKI backend is mounted.",
+			},
+			fieldRevokedOnly: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only list the revoked certificates.",
+			},
+			fieldSerialNumbers: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Serial numbers of the certificates, sorted.",
+			},
+			fieldCerts: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The certificates, in the order of serial_numbers.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldSerialNumber: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The certificate serial number.",
+						},
+						consts.FieldCommonName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The common name of the certificate.",
+						},
+						consts.FieldIssuerID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the issuer of the certificate.",
+						},
+						consts.FieldNotAfter: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The certificate expiration in RFC3339 format.",
+						},
+						consts.FieldExpiration: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The certificate expiration as a Unix-style timestamp.",
+						},
+						fieldRevoked: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "True if the certificate is revoked.",
+						},
+						fieldRevocationTime: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The revocation time as a Unix-style timestamp, 0 if not revoked.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readPKISecretBackendCerts(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backend := d.Get(consts.FieldBackend).(string)
+	serials, err := pki.ListCertificates(ctx, client, backend, d.Get(fieldRevokedOnly).(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[DEBUG] Listed %d certificates on PKI secret backend %q", len(serials), backend)
+
+	found := make([]string, 0, len(serials))
+	certs := make([]map[string]interface{}, 0, len(serials))
+	for _, serial := range serials {
+		cert, err := pki.ReadCertificate(ctx, client, backend, serial)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if cert == nil {
+			// removed by a tidy operation since it was listed
+			continue
+		}
+
+		found = append(found, serial)
+		certs = append(certs, map[string]interface{}{
+			consts.FieldSerialNumber: cert.SerialNumber,
+			consts.FieldCommonName:   cert.CommonName,
+			consts.FieldIssuerID:     cert.IssuerID,
+			consts.FieldNotAfter:     cert.NotAfter.Format(time.RFC3339),
+			consts.FieldExpiration:   cert.NotAfter.Unix(),
+			fieldRevoked:             cert.RevocationTime > 0,
+			fieldRevocationTime:      cert.RevocationTime,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/certs", backend))
+	if err := d.Set(fieldSerialNumbers, found); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(fieldCerts, certs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
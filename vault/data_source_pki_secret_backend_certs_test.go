@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccDataSourcePKISecretBackendCerts(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-pki")
+	all := "data.vault_pki_secret_backend_certs.all"
+	revoked := "data.vault_pki_secret_backend_certs.revoked"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPKISecretBackendRevocationConfig(backend, "vault_pki_secret_backend_cert.a.serial_number") + `
+data "vault_pki_secret_backend_certs" "all" {
+  backend    = vault_mount.test.path
+  depends_on = [vault_pki_secret_backend_revocation.test, vault_pki_secret_backend_cert.b]
+}
+
+data "vault_pki_secret_backend_certs" "revoked" {
+  backend      = vault_mount.test.path
+  revoked_only = true
+  depends_on   = [vault_pki_secret_backend_revocation.test]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					// the root certificate and the two issued certificates
+					resource.TestCheckResourceAttr(all, fieldSerialNumbers+".#", "3"),
+					resource.TestCheckResourceAttr(all, fieldCerts+".#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(all, fieldCerts+".*", map[string]string{
+						consts.FieldCommonName: "b.test.my.domain",
+						fieldRevoked:           "false",
+						fieldRevocationTime:    "0",
+					}),
+					resource.TestCheckResourceAttr(revoked, fieldSerialNumbers+".#", "1"),
+					resource.TestCheckResourceAttrPair(revoked, fieldSerialNumbers+".0", "vault_pki_secret_backend_cert.a", consts.FieldSerialNumber),
+					resource.TestCheckResourceAttr(revoked, fieldCerts+".0."+consts.FieldCommonName, "a.test.my.domain"),
+					resource.TestCheckResourceAttr(revoked, fieldCerts+".0."+fieldRevoked, "true"),
+					resource.TestCheckResourceAttrPair(revoked, fieldCerts+".0."+consts.FieldIssuerID, "vault_pki_secret_backend_root_cert.test", consts.FieldIssuerID),
+					resource.TestCheckResourceAttrSet(revoked, fieldCerts+".0."+consts.FieldNotAfter),
+					resource.TestCheckResourceAttrSet(revoked, fieldCerts+".0."+consts.FieldExpiration),
+				),
+			},
+		},
+	})
+}
@@ -6,62 +6,16 @@ package vault
 import (
 	"fmt"
 	"log"
-	"sort"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/terraform-provider-vault/helper"
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/policy"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-type Policy struct {
-	Rules []*PolicyRule
-}
-
-type PolicyRule struct {
-	// Path in Vault that the rule applies to.
-	Path string
-
-	// Description is an optional annotation for the rule.
-	Description string
-
-	// MinWrappingTTL is the minimum allowed TTL for the wrapped response.
-	MinWrappingTTL string
-
-	// MaxWrappingTTL is the maximum allowed TTL for the wrapped response.
-	MaxWrappingTTL string
-
-	// Capabilities is the list of allowed operations on the specified path.
-	Capabilities []string
-
-	// RequiredParameters is a list of parameters that must be specified.
-	RequiredParameters []string
-
-	// SubscribeEventTypes is a list of event types to subscribe to when using `subscribe` capability.
-	SubscribeEventTypes []string
-
-	// AllowedParameters defines a whitelist of keys and values that are permitted on the given path.
-	AllowedParameters map[string][]string
-
-	// DeniedParameters defines a blacklist of keys and values that are denied on the given path.
-	DeniedParameters map[string][]string
-}
-
-var allowedCapabilities = []string{
-	"create",
-	"read",
-	"update",
-	"delete",
-	"list",
-	"sudo",
-	"deny",
-	"patch",
-	"subscribe",
-}
-
 func policyDocumentDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: provider.ReadWrapper(policyDocumentDataSourceRead),
@@ -188,15 +142,15 @@ func policyDocumentDataSource() *schema.Resource {
 }
 
 func policyDocumentDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	policy := &Policy{}
+	p := &policy.Policy{}
 
 	if rawRules, hasRawRules := d.GetOk("rule"); hasRawRules {
 		rawRuleIntfs := rawRules.([]interface{})
-		rules := make([]*PolicyRule, len(rawRuleIntfs))
+		rules := make([]*policy.PolicyRule, len(rawRuleIntfs))
 
 		for i, ruleI := range rawRuleIntfs {
 			rawRule := ruleI.(map[string]interface{})
-			rule := &PolicyRule{}
+			rule := &policy.PolicyRule{}
 
 			pathVal, ok := rawRule[consts.FieldPath].(string)
 			if !ok || pathVal == "" {
@@ -262,10 +216,10 @@ func policyDocumentDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 			rules[i] = rule
 		}
 
-		policy.Rules = rules
+		p.Rules = rules
 	}
 
-	policyHCL := renderPolicy(policy)
+	policyHCL := policy.Render(p)
 	log.Printf("[DEBUG] Policy HCL is: %s", policyHCL)
 
 	err := d.Set("hcl", policyHCL)
@@ -278,10 +232,8 @@ func policyDocumentDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func capabilityValidation(configI interface{}, k string) ([]string, []error) {
-	for _, capability := range allowedCapabilities {
-		if configI.(string) == capability {
-			return nil, nil
-		}
+	if policy.IsValidCapability(configI.(string)) {
+		return nil, nil
 	}
 	return nil, []error{fmt.Errorf("invalid capability: \"%s\" in: %s", configI.(string), k)}
 }
@@ -309,76 +261,3 @@ func policyDecodeConfigListOfMapsOfListToString(input []interface{}) (map[string
 	}
 	return output, nil
 }
-
-func policyRenderListOfStrings(items []string) string {
-	if len(items) > 0 {
-		return fmt.Sprintf(`["%s"]`, strings.Join(items, `", "`))
-	}
-
-	return "[]"
-}
-
-func policyRenderListOfMapsOfListToString(input map[string][]string) string {
-	output := fmt.Sprintf("{\n")
-
-	keys := make([]string, 0, len(input))
-	for k := range input {
-		keys = append(keys, k)
-	}
-	sort.Strings(keys)
-
-	for _, k := range keys {
-		output = fmt.Sprintf("%s    \"%s\" = %s\n", output, k, policyRenderListOfStrings(input[k]))
-	}
-
-	return fmt.Sprintf("%s  }", output)
-}
-
-func policyRenderPolicyRule(rule *PolicyRule) string {
-	renderedRule := fmt.Sprintf("path \"%s\" {\n", rule.Path)
-	renderedRule = fmt.Sprintf("%s  capabilities = %s\n", renderedRule, policyRenderListOfStrings(rule.Capabilities))
-
-	if rule.Description != "" {
-		renderedRule = fmt.Sprintf("# %s\n%s", rule.Description, renderedRule)
-	}
-
-	if len(rule.RequiredParameters) > 0 {
-		renderedRule = fmt.Sprintf("%s  required_parameters = %s\n", renderedRule, policyRenderListOfStrings(rule.RequiredParameters))
-	}
-
-	if len(rule.SubscribeEventTypes) > 0 {
-		renderedRule = fmt.Sprintf("%s  subscribe_event_types = %s\n", renderedRule, policyRenderListOfStrings(rule.SubscribeEventTypes))
-	}
-
-	if len(rule.AllowedParameters) > 0 {
-		renderedRule = fmt.Sprintf("%s  allowed_parameters = %s\n", renderedRule, policyRenderListOfMapsOfListToString(rule.AllowedParameters))
-	}
-
-	if len(rule.DeniedParameters) > 0 {
-		renderedRule = fmt.Sprintf("%s  denied_parameters = %s\n", renderedRule, policyRenderListOfMapsOfListToString(rule.DeniedParameters))
-	}
-
-	if rule.MinWrappingTTL != "" {
-		renderedRule = fmt.Sprintf("%s  min_wrapping_ttl = \"%s\"\n", renderedRule, rule.MinWrappingTTL)
-	}
-
-	if rule.MaxWrappingTTL != "" {
-		renderedRule = fmt.Sprintf("%s  max_wrapping_ttl = \"%s\"\n", renderedRule, rule.MaxWrappingTTL)
-	}
-
-	return fmt.Sprintf("%s}\n", renderedRule)
-}
-
-func renderPolicy(policy *Policy) string {
-	var output string
-
-	for i, rule := range policy.Rules {
-		if i == 0 {
-			output = fmt.Sprintf("%s", policyRenderPolicyRule(rule))
-		} else {
-			output = fmt.Sprintf("%s\n%s", output, policyRenderPolicyRule(rule))
-		}
-	}
-
-	return output
-}
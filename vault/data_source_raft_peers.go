@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const (
+	raftConfigurationPath = "sys/storage/raft/configuration"
+
+	fieldPeers           = "peers"
+	fieldNodeID          = "node_id"
+	fieldVoter           = "voter"
+	fieldProtocolVersion = "protocol_version"
+)
+
+// raftPeer is a server of the Raft configuration.
+type raftPeer struct {
+	NodeID          string `json:"node_id"`
+	Address         string `json:"address"`
+	Leader          bool   `json:"leader"`
+	Voter           bool   `json:"voter"`
+	ProtocolVersion string `json:"protocol_version"`
+}
+
+func raftPeersDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: provider.ReadContextWrapper(raftPeersDataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			fieldPeers: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Servers of the Raft cluster, in the order returned by Vault.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						fieldNodeID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the node.",
+						},
+						consts.FieldAddress: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cluster address of the node.",
+						},
+						consts.FieldLeader: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "True if the node is the leader of the cluster.",
+						},
+						fieldVoter: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "True if the node is a voter.",
+						},
+						fieldProtocolVersion: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Raft protocol version of the node.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func raftPeersDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	peers, err := readRaftPeers(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(raftConfigurationPath)
+	if err := d.Set(fieldPeers, flattenRaftPeers(peers)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// readRaftPeers returns the servers of the Raft configuration.
+func readRaftPeers(ctx context.Context, client *api.Client) ([]raftPeer, error) {
+	log.Printf("[DEBUG] Reading raft configuration %q", raftConfigurationPath)
+	resp, err := client.Logical().ReadWithContext(ctx, raftConfigurationPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading raft configuration %q: %w", raftConfigurationPath, err)
+	}
+	if resp == nil || resp.Data == nil {
+		return nil, fmt.Errorf("no raft configuration found at %q", raftConfigurationPath)
+	}
+
+	var data struct {
+		Config struct {
+			Servers []raftPeer `json:"servers"`
+		} `json:"config"`
+	}
+	b, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error converting vault response to JSON; err=%s", err)
+	}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, fmt.Errorf("error decoding raft configuration %q: %w", raftConfigurationPath, err)
+	}
+
+	return data.Config.Servers, nil
+}
+
+func flattenRaftPeers(peers []raftPeer) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(peers))
+	for _, p := range peers {
+		result = append(result, map[string]interface{}{
+			fieldNodeID:          p.NodeID,
+			consts.FieldAddress:  p.Address,
+			consts.FieldLeader:   p.Leader,
+			fieldVoter:           p.Voter,
+			fieldProtocolVersion: p.ProtocolVersion,
+		})
+	}
+	return result
+}
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccDataSourceRaftPeers(t *testing.T) {
+	ds := "data.vault_raft_peers.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck: func() {
+			testutil.SkipTestEnvSet(t, "SKIP_RAFT_TESTS")
+			testutil.TestAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `data "vault_raft_peers" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(ds, fieldPeers+".#"),
+					resource.TestCheckResourceAttrSet(ds, fieldPeers+".0."+fieldNodeID),
+					resource.TestCheckResourceAttrSet(ds, fieldPeers+".0."+consts.FieldAddress),
+					resource.TestCheckResourceAttr(ds, fieldPeers+".0."+fieldVoter, "true"),
+				),
+			},
+		},
+	})
+}
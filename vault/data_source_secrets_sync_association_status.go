@@ -0,0 +1,177 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	syncutil "github.com/hashicorp/terraform-provider-vault/internal/sync"
+)
+
+const fieldAllSynced = "all_synced"
+
+func secretsSyncAssociationStatusDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: provider.ReadContextWrapper(secretsSyncAssociationStatusDataSourceRead),
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the destination.",
+			},
+			consts.FieldType: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Type of sync destination.",
+			},
+			consts.FieldMount: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Only return the secrets of this mount. " +
+					"The secrets of all mounts are returned if not set.",
+			},
+			fieldSecretName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the secret with this name.",
+			},
+			consts.FieldSecrets: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: "Sync status of each subkey of the associated secrets, sorted by " +
+					"mount accessor, secret name and subkey.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldAccessor: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Accessor of the mount of the secret.",
+						},
+						fieldSecretName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the secret.",
+						},
+						fieldSubkey: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Subkey of the secret, set if the destination's granularity is 'secret-key'.",
+						},
+						fieldSyncStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Sync status of the subkey.",
+						},
+						fieldUpdatedAt: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time at which the subkey was last synced.",
+						},
+						fieldLastError: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Last error reported for the sync of the subkey, if any.",
+						},
+					},
+				},
+			},
+			fieldAllSynced: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if every returned subkey is synced.",
+			},
+		},
+	}
+}
+
+func secretsSyncAssociationStatusDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	if !provider.IsAPISupported(meta, provider.VaultVersion116) {
+		return diag.FromErr(fmt.Errorf("secrets sync is not supported by this version of Vault, requires Vault 1.16 or later"))
+	}
+
+	destName := d.Get(consts.FieldName).(string)
+	destType := d.Get(consts.FieldType).(string)
+	secretName := d.Get(fieldSecretName).(string)
+
+	var accessor string
+	if mount, ok := d.GetOk(consts.FieldMount); ok {
+		var err error
+		accessor, err = getMountAccessor(ctx, d, meta, mount.(string))
+		if err != nil {
+			return diag.Errorf("could not obtain accessor from given mount; err=%s", err)
+		}
+	}
+
+	path := fmt.Sprintf("%s/%s", syncutil.SecretsSyncDestinationPath(destName, destType), "associations")
+	log.Printf("[DEBUG] Reading associations from %q", path)
+	resp, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return diag.Errorf("error reading associations from %q: %s", path, err)
+	}
+	if resp == nil {
+		return diag.Errorf("no associations found at %q", path)
+	}
+
+	associations, err := syncutil.DecodeSyncAssociations(resp.Data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secrets, allSynced := flattenSyncAssociationStatus(associations, accessor, secretName)
+
+	if err := d.Set(consts.FieldSecrets, secrets); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(fieldAllSynced, allSynced); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(path)
+
+	return nil
+}
+
+// flattenSyncAssociationStatus returns the status of the sorted associations
+// that match the accessor and secret name, if set, and whether all of them are
+// synced.
+func flattenSyncAssociationStatus(associations []syncutil.SyncAssociation, accessor, secretName string) ([]map[string]interface{}, bool) {
+	allSynced := true
+	secrets := make([]map[string]interface{}, 0, len(associations))
+	for _, v := range associations {
+		if accessor != "" && v.Accessor != accessor {
+			continue
+		}
+		if secretName != "" && v.SecretName != secretName {
+			continue
+		}
+
+		if v.SyncStatus != syncStatusSynced {
+			allSynced = false
+		}
+
+		secrets = append(secrets, map[string]interface{}{
+			consts.FieldAccessor: v.Accessor,
+			fieldSecretName:      v.SecretName,
+			fieldSubkey:          v.SubKey,
+			fieldSyncStatus:      v.SyncStatus,
+			fieldUpdatedAt:       v.UpdatedAt,
+			fieldLastError:       v.LastError,
+		})
+	}
+
+	return secrets, allSynced
+}
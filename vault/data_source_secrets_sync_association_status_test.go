@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	syncutil "github.com/hashicorp/terraform-provider-vault/internal/sync"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestFlattenSyncAssociationStatus(t *testing.T) {
+	associations, err := syncutil.DecodeSyncAssociations(map[string]interface{}{
+		"associated_secrets": map[string]interface{}{
+			"kv_1234/b":   map[string]interface{}{"accessor": "kv_1234", "secret_name": "b", "sync_status": "SYNCED"},
+			"kv_1234/a/2": map[string]interface{}{"accessor": "kv_1234", "secret_name": "a", "sub_key": "2", "sync_status": "SYNCED"},
+			"kv_1234/a/1": map[string]interface{}{
+				"accessor":    "kv_1234",
+				"secret_name": "a",
+				"sub_key":     "1",
+				"sync_status": "EXTERNAL_SERVICE_ERROR",
+				"last_error":  "rate limited",
+			},
+			"kv_5678/b": map[string]interface{}{"accessor": "kv_5678", "secret_name": "b", "sync_status": "SYNCED"},
+		},
+	})
+	require.NoError(t, err)
+
+	secrets, allSynced := flattenSyncAssociationStatus(associations, "", "")
+	assert.False(t, allSynced)
+	names := make([]string, 0, len(secrets))
+	for _, s := range secrets {
+		names = append(names, fmt.Sprintf("%s/%s/%s", s[consts.FieldAccessor], s[fieldSecretName], s[fieldSubkey]))
+	}
+	assert.Equal(t, []string{"kv_1234/a/1", "kv_1234/a/2", "kv_1234/b/", "kv_5678/b/"}, names)
+	assert.Equal(t, "rate limited", secrets[0][fieldLastError])
+
+	secrets, allSynced = flattenSyncAssociationStatus(associations, "", "b")
+	assert.True(t, allSynced)
+	assert.Len(t, secrets, 2)
+
+	secrets, allSynced = flattenSyncAssociationStatus(associations, "kv_5678", "b")
+	assert.True(t, allSynced)
+	assert.Len(t, secrets, 1)
+}
+
+func TestDataSourceSecretsSyncAssociationStatus(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-sync")
+	destName := acctest.RandomWithPrefix("tf-sync-dest")
+	secretName := acctest.RandomWithPrefix("tf-sync-secret")
+	dataSourceName := "data.vault_secrets_sync_association_status.test"
+
+	values := testutil.SkipTestEnvUnset(t,
+		"GITHUB_ACCESS_TOKEN",
+		"GITHUB_REPO_OWNER",
+		"GITHUB_REPO_NAME",
+	)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck: func() {
+			testutil.TestAccPreCheck(t)
+			SkipIfAPIVersionLT(t, testProvider.Meta(), provider.VaultVersion116)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testSecretsSyncAssociationConfig_gh(mount, values[0], values[1], values[2], destName, secretName, true) + `
+data "vault_secrets_sync_association_status" "test" {
+  name        = vault_secrets_sync_association.test.name
+  type        = vault_secrets_sync_association.test.type
+  mount       = vault_secrets_sync_association.test.mount
+  secret_name = vault_secrets_sync_association.test.secret_name
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "secrets.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "secrets.0.secret_name", secretName),
+					resource.TestCheckResourceAttr(dataSourceName, "secrets.0.sync_status", syncStatusSynced),
+					resource.TestCheckResourceAttr(dataSourceName, "secrets.0.last_error", ""),
+					resource.TestCheckResourceAttrSet(dataSourceName, "secrets.0.accessor"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "secrets.0.updated_at"),
+					resource.TestCheckResourceAttr(dataSourceName, fieldAllSynced, "true"),
+				),
+			},
+		},
+	})
+}
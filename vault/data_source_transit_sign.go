@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
 )
 
 func transitSignDataSource() *schema.Resource {
@@ -112,7 +113,7 @@ func transitSignDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 	payload := map[string]interface{}{}
 
 	if batchInput, ok := d.GetOk(consts.FieldBatchInput); ok {
-		payload[consts.FieldBatchInput], e = convertBatchInput(batchInput, []string{consts.FieldKeyVersion})
+		payload[consts.FieldBatchInput], e = util.ConvertBatchInput(batchInput, []string{consts.FieldKeyVersion})
 		if e != nil {
 			return e
 		}
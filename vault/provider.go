@@ -6,6 +6,7 @@ package vault
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/identity/mfa"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 )
@@ -49,6 +50,10 @@ var (
 			Resource:      UpdateSchemaResource(identityEntityDataSource()),
 			PathInventory: []string{"/identity/lookup/entity"},
 		},
+		"vault_identity_entities": {
+			Resource:      UpdateSchemaResource(identityEntitiesDataSource()),
+			PathInventory: []string{"/identity/lookup/entity"},
+		},
 		"vault_identity_group": {
 			Resource:      UpdateSchemaResource(identityGroupDataSource()),
 			PathInventory: []string{"/identity/lookup/group"},
@@ -159,6 +164,10 @@ var (
 			Resource:      UpdateSchemaResource(kvSecretListDataSourceV2()),
 			PathInventory: []string{"/secret/metadata/{path}/?list=true"},
 		},
+		"vault_kv_secrets_list_recursive_v2": {
+			Resource:      UpdateSchemaResource(kvSecretListRecursiveDataSourceV2()),
+			PathInventory: []string{"/secret/metadata/{path}/?list=true", "/secret/metadata/{path}"},
+		},
 		"vault_kv_secret_subkeys_v2": {
 			Resource:      UpdateSchemaResource(kvSecretSubkeysV2DataSource()),
 			PathInventory: []string{"/secret/subkeys/{path}"},
@@ -167,10 +176,18 @@ var (
 			Resource:      UpdateSchemaResource(raftAutopilotStateDataSource()),
 			PathInventory: []string{"/sys/storage/raft/autopilot/state"},
 		},
+		"vault_raft_peers": {
+			Resource:      UpdateSchemaResource(raftPeersDataSource()),
+			PathInventory: []string{"/sys/storage/raft/configuration"},
+		},
 		"vault_pki_secret_backend_cert_metadata": {
 			Resource:      UpdateSchemaResource(pkiSecretBackendCertMetadataDataSource()),
 			PathInventory: []string{"/pki/cert-metadata/{serial}"},
 		},
+		"vault_pki_secret_backend_certs": {
+			Resource:      UpdateSchemaResource(pkiSecretBackendCertsDataSource()),
+			PathInventory: []string{"/pki/certs", "/pki/certs/revoked", "/pki/cert/{serial}"},
+		},
 		"vault_pki_secret_backend_config_cmpv2": {
 			Resource:      UpdateSchemaResource(pkiSecretBackendConfigCMPV2DataSource()),
 			PathInventory: []string{"/pki/config/cmp"},
@@ -223,6 +240,10 @@ var (
 			Resource:      UpdateSchemaResource(transitCMACDataSource()),
 			PathInventory: []string{"/transit/cmac/{name}/{url_mac_length}"},
 		},
+		"vault_secrets_sync_association_status": {
+			Resource:      UpdateSchemaResource(secretsSyncAssociationStatusDataSource()),
+			PathInventory: []string{"/sys/sync/destinations/{type}/{name}/associations"},
+		},
 	}
 
 	ResourceRegistry = map[string]*provider.Description{
@@ -246,7 +267,7 @@ var (
 			},
 		},
 		"vault_auth_backend": {
-			Resource:      UpdateSchemaResource(AuthBackendResource()),
+			Resource:      UpdateSchemaResourceWithImportIdentity(AuthBackendResource()),
 			PathInventory: []string{"/sys/auth/{path}"},
 		},
 		"vault_token": {
@@ -314,7 +335,7 @@ var (
 			PathInventory: []string{"/aws/config/root"},
 		},
 		"vault_aws_secret_backend_role": {
-			Resource:      UpdateSchemaResource(awsSecretBackendRoleResource("vault_aws_secret_backend_role")),
+			Resource:      UpdateSchemaResourceWithImportIdentity(awsSecretBackendRoleResource("vault_aws_secret_backend_role")),
 			PathInventory: []string{"/aws/roles/{name}"},
 		},
 		"vault_aws_secret_backend_static_role": {
@@ -354,7 +375,7 @@ var (
 			PathInventory: []string{"/database/config/{name}"},
 		},
 		"vault_database_secret_backend_role": {
-			Resource:      UpdateSchemaResource(databaseSecretBackendRoleResource()),
+			Resource:      UpdateSchemaResourceWithImportIdentity(databaseSecretBackendRoleResource()),
 			PathInventory: []string{"/database/roles/{name}"},
 		},
 		"vault_database_secret_backend_static_role": {
@@ -406,8 +427,9 @@ var (
 			PathInventory: []string{"/auth/scep/role/{name}"},
 		},
 		"vault_generic_endpoint": {
-			Resource:      UpdateSchemaResource(genericEndpointResource("vault_generic_endpoint")),
-			PathInventory: []string{GenericPath},
+			Resource:        UpdateSchemaResource(genericEndpointResource("vault_generic_endpoint")),
+			PathInventory:   []string{GenericPath},
+			CapabilityPaths: capabilityPathsFromField(consts.FieldPath),
 		},
 		"vault_generic_secret": {
 			Resource:      UpdateSchemaResource(genericSecretResource("vault_generic_secret")),
@@ -490,7 +512,7 @@ var (
 			PathInventory: []string{"/auth/oci/role/{role}"},
 		},
 		"vault_policy": {
-			Resource:      UpdateSchemaResource(policyResource()),
+			Resource:      UpdateSchemaResourceWithImportIdentity(policyResource()),
 			PathInventory: []string{"/sys/policy/{name}"},
 		},
 		"vault_egp_policy": {
@@ -524,7 +546,7 @@ var (
 			EnterpriseOnly: true,
 		},
 		"vault_mount": {
-			Resource:      UpdateSchemaResource(MountResource()),
+			Resource:      UpdateSchemaResourceWithImportIdentity(MountResource()),
 			PathInventory: []string{"/sys/mounts/{path}"},
 		},
 		"vault_namespace": {
@@ -549,9 +571,13 @@ var (
 			PathInventory: []string{"/ssh/roles/{role}"},
 		},
 		"vault_identity_entity": {
-			Resource:      UpdateSchemaResource(identityEntityResource()),
+			Resource:      UpdateSchemaResourceWithImportIdentity(identityEntityResource()),
 			PathInventory: []string{"/identity/entity"},
 		},
+		"vault_identity_entity_merge": {
+			Resource:      UpdateSchemaResource(identityEntityMergeResource()),
+			PathInventory: []string{"/identity/entity/merge"},
+		},
 		"vault_identity_entity_alias": {
 			Resource:      UpdateSchemaResource(identityEntityAliasResource()),
 			PathInventory: []string{"/identity/entity-alias"},
@@ -561,7 +587,7 @@ var (
 			PathInventory: []string{"/identity/lookup/entity"},
 		},
 		"vault_identity_group": {
-			Resource:      UpdateSchemaResource(identityGroupResource()),
+			Resource:      UpdateSchemaResourceWithImportIdentity(identityGroupResource()),
 			PathInventory: []string{"/identity/group"},
 		},
 		"vault_identity_group_alias": {
@@ -572,6 +598,10 @@ var (
 			Resource:      UpdateSchemaResource(identityGroupMemberEntityIdsResource()),
 			PathInventory: []string{"/identity/group/id/{id}"},
 		},
+		"vault_identity_group_member_aliases": {
+			Resource:      UpdateSchemaResource(identityGroupMemberAliasesResource()),
+			PathInventory: []string{"/identity/group/id/{id}", "/identity/lookup/entity", "/identity/entity-alias"},
+		},
 		"vault_identity_group_member_group_ids": {
 			Resource:      UpdateSchemaResource(identityGroupMemberGroupIdsResource()),
 			PathInventory: []string{"/identity/group/id/{id}"},
@@ -611,6 +641,10 @@ var (
 			Resource:      UpdateSchemaResource(pkiSecretBackendCertResource()),
 			PathInventory: []string{"/pki/issue/{role}"},
 		},
+		"vault_pki_secret_backend_revocation": {
+			Resource:      UpdateSchemaResource(pkiSecretBackendRevocationResource()),
+			PathInventory: []string{"/pki/revoke", "/pki/cert/{serial}", "/pki/issuer/{issuer_ref}/crl"},
+		},
 		"vault_pki_secret_backend_crl_config": {
 			Resource:      UpdateSchemaResource(pkiSecretBackendCrlConfigResource()),
 			PathInventory: []string{"/pki/config/crl"},
@@ -656,7 +690,7 @@ var (
 			PathInventory: []string{"/pki/intermediate/set-signed"},
 		},
 		"vault_pki_secret_backend_role": {
-			Resource:      UpdateSchemaResource(pkiSecretBackendRoleResource()),
+			Resource:      UpdateSchemaResourceWithImportIdentity(pkiSecretBackendRoleResource()),
 			PathInventory: []string{"/pki/roles/{name}"},
 		},
 		"vault_pki_secret_backend_root_cert": {
@@ -719,6 +753,13 @@ var (
 			Resource:      UpdateSchemaResource(raftAutopilotConfigResource()),
 			PathInventory: []string{"/sys/storage/raft/autopilot/configuration"},
 		},
+		"vault_raft_peer_removal": {
+			Resource: UpdateSchemaResource(raftPeerRemovalResource()),
+			PathInventory: []string{
+				"/sys/storage/raft/configuration",
+				"/sys/storage/raft/remove-peer",
+			},
+		},
 		"vault_kmip_secret_backend": {
 			Resource:      UpdateSchemaResource(kmipSecretBackendResource()),
 			PathInventory: []string{"/kmip/config"},
@@ -760,12 +801,29 @@ var (
 			PathInventory: []string{"/secret/data/{path}"},
 		},
 		"vault_kv_secret": {
-			Resource:      UpdateSchemaResource(kvSecretResource("vault_kv_secret")),
-			PathInventory: []string{"/secret/{path}"},
+			Resource:        UpdateSchemaResource(kvSecretResource("vault_kv_secret")),
+			PathInventory:   []string{"/secret/{path}"},
+			CapabilityPaths: capabilityPathsFromField(consts.FieldPath),
 		},
 		"vault_kv_secret_v2": {
-			Resource:      UpdateSchemaResource(kvSecretV2Resource("vault_kv_secret_v2")),
-			PathInventory: []string{"/secret/data/{path}"},
+			Resource:        UpdateSchemaResourceWithImportIdentity(kvSecretV2Resource("vault_kv_secret_v2")),
+			PathInventory:   []string{"/secret/data/{path}"},
+			CapabilityPaths: kvSecretV2CapabilityPaths,
+		},
+		"vault_kv_secret_v2_version_state": {
+			Resource: UpdateSchemaResource(kvSecretV2VersionStateResource()),
+			PathInventory: []string{
+				"/secret/delete/{path}",
+				"/secret/undelete/{path}",
+				"/secret/destroy/{path}",
+				"/secret/metadata/{path}",
+			},
+			CapabilityPaths: kvSecretV2VersionStateCapabilityPaths,
+		},
+		"vault_kv_secret_v2_rollback": {
+			Resource:        UpdateSchemaResource(kvSecretV2RollbackResource()),
+			PathInventory:   []string{"/secret/data/{path}"},
+			CapabilityPaths: kvSecretV2CapabilityPaths,
 		},
 		"vault_kubernetes_secret_backend": {
 			Resource:      UpdateSchemaResource(kubernetesSecretBackendResource()),
@@ -835,6 +893,14 @@ var (
 			Resource:      UpdateSchemaResource(vercelSecretsSyncDestinationResource()),
 			PathInventory: []string{"/sys/sync/destinations/vercel-project/{name}"},
 		},
+		"vault_secrets_sync_gitlab_destination": {
+			Resource:      UpdateSchemaResource(gitlabSecretsSyncDestinationResource()),
+			PathInventory: []string{"/sys/sync/destinations/gitlab/{name}"},
+		},
+		"vault_secrets_sync_tfc_destination": {
+			Resource:      UpdateSchemaResource(tfcSecretsSyncDestinationResource()),
+			PathInventory: []string{"/sys/sync/destinations/tfc/{name}"},
+		},
 		"vault_secrets_sync_association": {
 			Resource:      UpdateSchemaResource(secretsSyncAssociationResource()),
 			PathInventory: []string{"/sys/sync/destinations/{type}/{name}/associations/set"},
@@ -861,3 +927,22 @@ func UpdateSchemaResource(r *schema.Resource) *schema.Resource {
 
 	return r
 }
+
+// capabilityPathsFromField returns a provider.CapabilityPathsFunc for
+// resources that write to the path in the given field.
+func capabilityPathsFromField(field string) provider.CapabilityPathsFunc {
+	return func(d *schema.ResourceDiff) ([]string, error) {
+		if !d.NewValueKnown(field) {
+			return nil, nil
+		}
+
+		return []string{d.Get(field).(string)}, nil
+	}
+}
+
+// UpdateSchemaResourceWithImportIdentity is UpdateSchemaResource for resources
+// that can be discovered by a list resource. See
+// provider.MustAddImportByIDIdentity.
+func UpdateSchemaResourceWithImportIdentity(r *schema.Resource) *schema.Resource {
+	return provider.MustAddImportByIDIdentity(UpdateSchemaResource(r))
+}
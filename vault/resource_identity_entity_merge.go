@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/identity/entity"
+	"github.com/hashicorp/terraform-provider-vault/internal/identity/group"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+const (
+	identityEntityMergePath = "identity/entity/merge"
+
+	fieldToEntityID                = "to_entity_id"
+	fieldFromEntityIDs             = "from_entity_ids"
+	fieldConflictingAliasIDsToKeep = "conflicting_alias_ids_to_keep"
+	fieldMergedEntityIDs           = "merged_entity_ids"
+)
+
+func identityEntityMergeResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: identityEntityMergeWrite,
+		UpdateContext: identityEntityMergeWrite,
+		ReadContext:   provider.ReadContextWrapper(identityEntityMergeRead),
+		DeleteContext: identityEntityMergeDelete,
+
+		Schema: map[string]*schema.Schema{
+			fieldToEntityID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the entity into which the entities are merged.",
+			},
+			fieldFromEntityIDs: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the entities to merge into the target entity. They are deleted by the merge.",
+			},
+			consts.FieldForce: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Merge the entities even if they have aliases on the same mount. " +
+					"Only one of the conflicting aliases is kept.",
+			},
+			fieldConflictingAliasIDsToKeep: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the aliases to keep when the entities have aliases on the same mount. " +
+					"Requires Vault 1.12+.",
+			},
+			fieldMergedEntityIDs: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of all the entities that were merged into the target entity.",
+			},
+		},
+	}
+}
+
+// identityEntityMergeWrite merges the entities that are not merged into the
+// target entity yet. The entities merged by an earlier apply were deleted by
+// Vault, so merging them again would fail.
+func identityEntityMergeWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	toEntityID := d.Get(fieldToEntityID).(string)
+	data := map[string]interface{}{
+		fieldToEntityID:   toEntityID,
+		consts.FieldForce: d.Get(consts.FieldForce).(bool),
+	}
+
+	if v, ok := d.GetOk(fieldConflictingAliasIDsToKeep); ok {
+		if !provider.IsAPISupported(meta, provider.VaultVersion112) {
+			return diag.Errorf("%s requires Vault 1.12 or later", fieldConflictingAliasIDsToKeep)
+		}
+		data[fieldConflictingAliasIDsToKeep] = expandStringSlice(v.(*schema.Set).List())
+	}
+
+	path := entity.JoinEntityID(toEntityID)
+	provider.VaultMutexKV.Lock(path)
+	defer provider.VaultMutexKV.Unlock(path)
+
+	resp, err := readIdentityEntity(client, toEntityID, false)
+	if err != nil {
+		return diag.Errorf("error reading IdentityEntity %q: %s", toEntityID, err)
+	}
+
+	fromEntityIDs := identityEntityUnmergedIDs(resp,
+		expandStringSlice(d.Get(fieldFromEntityIDs).(*schema.Set).List()))
+	if len(fromEntityIDs) > 0 {
+		data[fieldFromEntityIDs] = fromEntityIDs
+
+		log.Printf("[DEBUG] Merging entities %v into IdentityEntity %q", fromEntityIDs, toEntityID)
+		if _, err := client.Logical().WriteWithContext(ctx, identityEntityMergePath, data); err != nil {
+			return diag.Errorf("error merging entities into IdentityEntity %q: %s", toEntityID, err)
+		}
+		log.Printf("[DEBUG] Merged entities into IdentityEntity %q", toEntityID)
+	} else {
+		log.Printf("[DEBUG] Entities are already merged into IdentityEntity %q", toEntityID)
+	}
+
+	d.SetId(toEntityID)
+
+	return identityEntityMergeRead(ctx, d, meta)
+}
+
+func identityEntityMergeRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	id := d.Id()
+
+	resp, err := readIdentityEntity(client, id, d.IsNewResource())
+	if err != nil {
+		if util.IsExpiredTokenErr(err) {
+			return nil
+		}
+
+		if group.IsIdentityNotFoundError(err) {
+			log.Printf("[WARN] IdentityEntity %q not found, removing entity merge from state", id)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading IdentityEntity %q: %s", id, err)
+	}
+
+	if err := d.Set(fieldToEntityID, id); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(fieldMergedEntityIDs, resp.Data[fieldMergedEntityIDs]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// identityEntityUnmergedIDs returns the IDs that are not in the
+// merged_entity_ids of the target entity.
+func identityEntityUnmergedIDs(resp *api.Secret, ids []string) []string {
+	merged := map[string]bool{}
+	if resp != nil {
+		v, _ := resp.Data[fieldMergedEntityIDs].([]interface{})
+		for _, id := range v {
+			if s, ok := id.(string); ok {
+				merged[s] = true
+			}
+		}
+	}
+
+	var result []string
+	for _, id := range ids {
+		if !merged[id] {
+			result = append(result, id)
+		}
+	}
+
+	return result
+}
+
+// identityEntityMergeDelete only removes the resource from the state, since
+// a merge cannot be undone.
+func identityEntityMergeDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing entity merge into IdentityEntity %q from state", d.Id())
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccIdentityEntityMerge(t *testing.T) {
+	name := acctest.RandomWithPrefix("entity")
+	resourceName := "vault_identity_entity_merge.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityEntityMergeConfig(name, "from"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, fieldToEntityID, "vault_identity_entity.to", consts.FieldID),
+					resource.TestCheckResourceAttr(resourceName, fieldFromEntityIDs+".#", "1"),
+					resource.TestCheckResourceAttr(resourceName, fieldMergedEntityIDs+".#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, fieldMergedEntityIDs+".*", "vault_identity_entity.from", consts.FieldID),
+				),
+				// The merge deletes the source entity, so the next plan
+				// recreates it.
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// adding an entity updates the merge instead of replacing it
+				Config: testAccIdentityEntityMergeConfig(name, "from", "more"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, fieldFromEntityIDs+".#", "2"),
+					resource.TestCheckResourceAttr(resourceName, fieldMergedEntityIDs+".#", "3"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, fieldMergedEntityIDs+".*", "vault_identity_entity.more", consts.FieldID),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestIdentityEntityUnmergedIDs(t *testing.T) {
+	resp := &api.Secret{
+		Data: map[string]interface{}{
+			fieldMergedEntityIDs: []interface{}{"a", "b"},
+		},
+	}
+
+	assert.Equal(t, []string{"c"}, identityEntityUnmergedIDs(resp, []string{"a", "c"}))
+	assert.Empty(t, identityEntityUnmergedIDs(resp, []string{"b"}))
+	assert.Equal(t, []string{"a"}, identityEntityUnmergedIDs(&api.Secret{}, []string{"a"}))
+}
+
+func testAccIdentityEntityMergeConfig(name string, from ...string) string {
+	ret := fmt.Sprintf(`
+resource "vault_identity_entity" "to" {
+  name = "%s-to"
+}
+`, name)
+
+	var ids []string
+	for _, f := range from {
+		ret += fmt.Sprintf(`
+resource "vault_identity_entity" "%[2]s" {
+  name = "%[1]s-%[2]s"
+}
+`, name, f)
+		ids = append(ids, fmt.Sprintf("vault_identity_entity.%s.id", f))
+	}
+
+	return ret + fmt.Sprintf(`
+resource "vault_identity_entity_merge" "test" {
+  to_entity_id    = vault_identity_entity.to.id
+  from_entity_ids = [%s]
+}
+`, strings.Join(ids, ", "))
+}
@@ -0,0 +1,253 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/identity/entity"
+	"github.com/hashicorp/terraform-provider-vault/internal/identity/group"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const fieldMember = "member"
+
+// groupMemberAliases are the alias names of the group members in one auth
+// mount.
+type groupMemberAliases struct {
+	MountAccessor string
+	Names         []string
+}
+
+func identityGroupMemberAliasesResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: identityGroupMemberAliasesWrite,
+		UpdateContext: identityGroupMemberAliasesWrite,
+		ReadContext:   provider.ReadContextWrapper(identityGroupMemberAliasesRead),
+		DeleteContext: identityGroupMemberAliasesDelete,
+		CustomizeDiff: identityGroupMemberAliasesCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldGroupID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the internal group.",
+			},
+			fieldMember: {
+				Type:     schema.TypeSet,
+				Required: true,
+				Description: "Alias names of the group members in an auth mount. The entity of each alias " +
+					"is looked up, and the alias is created along with a new entity if it does not exist.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldMountAccessor: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Accessor of the auth mount of the aliases.",
+						},
+						consts.FieldNames: {
+							Type:        schema.TypeSet,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Names of the aliases, e.g. the user names of the auth method.",
+						},
+					},
+				},
+			},
+			consts.FieldExclusive: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: `If set to true, allows the resource to manage member entity ids
+exclusively. Beware of race conditions when disabling exclusive management`,
+			},
+			consts.FieldMemberEntityIDs: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the member entities managed by this resource.",
+			},
+		},
+	}
+}
+
+func identityGroupMemberAliasesWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	gid := d.Get(consts.FieldGroupID).(string)
+	path := group.IdentityGroupIDPath(gid)
+	provider.VaultMutexKV.Lock(path)
+	defer provider.VaultMutexKV.Unlock(path)
+
+	// the group is checked before any entity or alias is created, so that a
+	// wrong group_id does not leave orphan entities behind.
+	resp, err := group.ReadIdentityGroup(client, gid, d.IsNewResource())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if t, _ := resp.Data[consts.FieldType].(string); t == consts.FieldExternal {
+		return diag.Errorf("Identity Group %s is external, its members are managed by its group alias", gid)
+	}
+
+	ids, _, err := resolveGroupMemberAliases(ctx, client, expandGroupMemberAliases(d.Get(fieldMember).(*schema.Set)), true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(consts.FieldMemberEntityIDs, ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data, err := group.GetGroupMember(d, resp, consts.FieldMemberEntityIDs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Updating member entities of Identity Group %s", gid)
+	if _, err := client.Logical().WriteWithContext(ctx, path, data); err != nil {
+		return diag.Errorf("error updating member entities of Identity Group %s: %s", gid, err)
+	}
+	log.Printf("[DEBUG] Updated member entities of Identity Group %s", gid)
+
+	d.SetId(gid)
+
+	return identityGroupMemberAliasesRead(ctx, d, meta)
+}
+
+func identityGroupMemberAliasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return group.GetGroupMemberReadContextFunc(group.EntityResourceType)(ctx, d, meta)
+}
+
+// identityGroupMemberAliasesDelete removes the managed entities from the
+// group. The entities and aliases created by the resource are kept, since
+// they may have been used to log in.
+func identityGroupMemberAliasesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return group.GetGroupMemberDeleteContextFunc(group.EntityResourceType)(ctx, d, meta)
+}
+
+// identityGroupMemberAliasesCustomizeDiff plans an update when an alias no
+// longer exists, or when the entities of the aliases are not the members of
+// the group anymore, e.g. after an alias was moved to another entity.
+func identityGroupMemberAliasesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if d.HasChange(fieldMember) || d.HasChange(consts.FieldExclusive) {
+		return d.SetNewComputed(consts.FieldMemberEntityIDs)
+	}
+
+	client, err := provider.GetClient(d, meta)
+	if err != nil {
+		return err
+	}
+
+	ids, missing, err := resolveGroupMemberAliases(ctx, client, expandGroupMemberAliases(d.Get(fieldMember).(*schema.Set)), false)
+	if err != nil {
+		return err
+	}
+
+	want := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		want = append(want, id)
+	}
+	if missing > 0 || !d.Get(consts.FieldMemberEntityIDs).(*schema.Set).Equal(schema.NewSet(schema.HashString, want)) {
+		return d.SetNewComputed(consts.FieldMemberEntityIDs)
+	}
+
+	return nil
+}
+
+func expandGroupMemberAliases(s *schema.Set) []groupMemberAliases {
+	var result []groupMemberAliases
+	for _, raw := range s.List() {
+		m := raw.(map[string]interface{})
+		result = append(result, groupMemberAliases{
+			MountAccessor: m[consts.FieldMountAccessor].(string),
+			Names:         expandStringSlice(m[consts.FieldNames].(*schema.Set).List()),
+		})
+	}
+	return result
+}
+
+// resolveGroupMemberAliases returns the IDs of the entities of the aliases,
+// without duplicates. If create is true, the missing aliases are created
+// along with a new entity, otherwise they are skipped and their number is
+// returned.
+func resolveGroupMemberAliases(ctx context.Context, client *api.Client, members []groupMemberAliases, create bool) ([]string, int, error) {
+	var ids []string
+	seen := map[string]bool{}
+	add := func(id string) {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	var missing int
+	for _, m := range members {
+		matches, err := lookupIdentityEntitiesByAlias(ctx, client, m.Names, []string{m.MountAccessor})
+		if err != nil {
+			return nil, 0, err
+		}
+
+		found := make(map[string]bool, len(matches))
+		for _, match := range matches {
+			found[match.AliasName] = true
+			add(match.EntityID)
+		}
+
+		for _, name := range m.Names {
+			if found[name] {
+				continue
+			}
+			if !create {
+				missing++
+				continue
+			}
+
+			id, err := createIdentityEntityAlias(ctx, client, name, m.MountAccessor)
+			if err != nil {
+				return nil, 0, err
+			}
+			add(id)
+		}
+	}
+
+	return ids, missing, nil
+}
+
+// createIdentityEntityAlias creates an alias without a canonical ID, so that
+// Vault creates a new entity for it, and returns the ID of the entity.
+func createIdentityEntityAlias(ctx context.Context, client *api.Client, name, mountAccessor string) (string, error) {
+	log.Printf("[DEBUG] Creating IdentityEntityAlias %q in mount %q", name, mountAccessor)
+	resp, err := client.Logical().WriteWithContext(ctx, entity.RootAliasPath, map[string]interface{}{
+		consts.FieldName:          name,
+		consts.FieldMountAccessor: mountAccessor,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error creating IdentityEntityAlias %q in mount %q: %w", name, mountAccessor, err)
+	}
+	if resp == nil || resp.Data == nil {
+		return "", fmt.Errorf("no response creating IdentityEntityAlias %q in mount %q", name, mountAccessor)
+	}
+
+	id, _ := resp.Data["canonical_id"].(string)
+	if id == "" {
+		return "", fmt.Errorf("no entity created for IdentityEntityAlias %q in mount %q", name, mountAccessor)
+	}
+	log.Printf("[DEBUG] Created IdentityEntityAlias %q in mount %q for IdentityEntity %q", name, mountAccessor, id)
+
+	return id, nil
+}
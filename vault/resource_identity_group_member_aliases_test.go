@@ -0,0 +1,216 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccIdentityGroupMemberAliases(t *testing.T) {
+	name := acctest.RandomWithPrefix("group")
+	resourceName := "vault_identity_group_member_aliases.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityGroupMemberAliasesConfig(name, `"alice", "bob"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, consts.FieldGroupID, "vault_identity_group.test", consts.FieldID),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMemberEntityIDs+".#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, consts.FieldMemberEntityIDs+".*", "vault_identity_entity.alice", consts.FieldID),
+				),
+			},
+			{
+				Config: testAccIdentityGroupMemberAliasesConfig(name, `"alice", "bob", "carol"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMemberEntityIDs+".#", "3"),
+				),
+			},
+			{
+				Config: testAccIdentityGroupMemberAliasesConfig(name, `"carol"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldMemberEntityIDs+".#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIdentityGroupMemberAliasesConfig(name, names string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "userpass" {
+  type = "userpass"
+  path = "%[1]s"
+}
+
+resource "vault_identity_entity" "alice" {
+  name = "%[1]s-alice"
+}
+
+resource "vault_identity_entity_alias" "alice" {
+  name           = "alice"
+  mount_accessor = vault_auth_backend.userpass.accessor
+  canonical_id   = vault_identity_entity.alice.id
+}
+
+resource "vault_identity_group" "test" {
+  name                       = "%[1]s"
+  type                       = "internal"
+  external_member_entity_ids = true
+}
+
+resource "vault_identity_group_member_aliases" "test" {
+  group_id = vault_identity_group.test.id
+
+  member {
+    mount_accessor = vault_identity_entity_alias.alice.mount_accessor
+    names          = [%[2]s]
+  }
+}
+`, name, names)
+}
+
+func TestAccIdentityGroupMemberAliases_externalGroup(t *testing.T) {
+	name := acctest.RandomWithPrefix("group")
+	config := fmt.Sprintf(`
+resource "vault_auth_backend" "userpass" {
+  type = "userpass"
+  path = "%[1]s"
+}
+
+resource "vault_identity_group" "test" {
+  name = "%[1]s"
+  type = "external"
+}
+`, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+resource "vault_identity_group_member_aliases" "test" {
+  group_id = vault_identity_group.test.id
+
+  member {
+    mount_accessor = vault_auth_backend.userpass.accessor
+    names          = ["orphan"]
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`is external, its members are managed by its group alias`),
+			},
+			{
+				Config: config,
+				Check:  testAccCheckIdentityGroupMemberAliasNotCreated("vault_auth_backend.userpass", "orphan"),
+			},
+		},
+	})
+}
+
+// testAccCheckIdentityGroupMemberAliasNotCreated checks that no entity was
+// created for the alias name on the auth mount of resourceName.
+func testAccCheckIdentityGroupMemberAliasNotCreated(resourceName, aliasName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, err := testutil.GetResourceFromRootModule(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		client, err := provider.GetClient(rs.Primary, testProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Logical().Write("identity/lookup/entity", map[string]interface{}{
+			"alias_name":           aliasName,
+			"alias_mount_accessor": rs.Primary.Attributes[consts.FieldAccessor],
+		})
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("expected no entity for alias %q, got %v", aliasName, resp.Data[consts.FieldID])
+		}
+
+		return nil
+	}
+}
+
+func TestResolveGroupMemberAliases(t *testing.T) {
+	aliases := map[string]string{
+		"alice/acc-1": "id-alice",
+		"bob/acc-2":   "id-bob",
+		"carol/acc-2": "id-alice",
+	}
+
+	var created []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		var data map[string]interface{}
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/v1/identity/lookup/entity":
+			id, ok := aliases[body["alias_name"]+"/"+body["alias_mount_accessor"]]
+			if !ok {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			data = map[string]interface{}{"id": id}
+		case r.Method == http.MethodPut && r.URL.Path == "/v1/identity/entity-alias":
+			key := body[consts.FieldName] + "/" + body[consts.FieldMountAccessor]
+			created = append(created, key)
+			data = map[string]interface{}{"id": "alias-" + key, "canonical_id": "id-" + body[consts.FieldName]}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	})
+
+	config, ln := testutil.TestHTTPServer(t, handler)
+	defer ln.Close()
+
+	client, err := api.NewClient(config)
+	require.NoError(t, err)
+
+	members := []groupMemberAliases{
+		{MountAccessor: "acc-1", Names: []string{"alice", "dave"}},
+		{MountAccessor: "acc-2", Names: []string{"bob", "carol"}},
+	}
+
+	ids, missing, err := resolveGroupMemberAliases(context.Background(), client, members, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id-alice", "id-bob"}, ids)
+	assert.Equal(t, 1, missing)
+	assert.Empty(t, created)
+
+	ids, missing, err = resolveGroupMemberAliases(context.Background(), client, members, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id-alice", "id-dave", "id-bob"}, ids)
+	assert.Equal(t, 0, missing)
+	assert.Equal(t, []string{"dave/acc-1"}, created)
+}
@@ -10,10 +10,13 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"log"
 	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
@@ -21,6 +24,11 @@ import (
 	"github.com/hashicorp/terraform-provider-vault/util"
 )
 
+const (
+	kvV2WriteModePut   = "put"
+	kvV2WriteModePatch = "patch"
+)
+
 var (
 	kvV2SecretMountFromPathRegex = regexp.MustCompile("^(.+?)/data/.+$")
 	kvV2SecretNameFromPathRegex  = regexp.MustCompile("^.+?/data/(.+?)$")
@@ -78,13 +86,34 @@ func kvSecretV2Resource(name string) *schema.Resource {
 				Computed:    true,
 				Description: "Full path where the KV-V2 secret will be written.",
 			},
-			"cas": {
+			consts.FieldCAS: {
 				Type:     schema.TypeInt,
 				Optional: true,
 				Description: "This flag is required if cas_required is set to true " +
 					"on either the secret or the engine's config. In order for a " +
 					"write to be successful, cas must be set to the current version " +
 					"of the secret.",
+				ConflictsWith: []string{consts.FieldAutoCAS},
+			},
+			consts.FieldAutoCAS: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "If set to true, the version last written by this resource is " +
+					"sent as cas on every update, so that updates fail if the secret was " +
+					"written outside of Terraform.",
+				ConflictsWith: []string{consts.FieldCAS},
+			},
+			consts.FieldLastWrittenVersion: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the secret that was last written by this resource.",
+			},
+			consts.FieldWriteMode: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "How the secret data is written, 'put' replaces the secret data and " +
+					"'patch' only merges the keys managed by this resource. Defaults to 'put'.",
+				ValidateFunc: validation.StringInSlice([]string{kvV2WriteModePut, kvV2WriteModePatch}, false),
 			},
 			"options": {
 				Type:        schema.TypeMap,
@@ -192,6 +221,136 @@ func getKVV2Path(mount, name, prefix string) string {
 	return fmt.Sprintf("%s/%s/%s", mount, prefix, name)
 }
 
+// kvSecretV2CapabilityPaths returns the paths that kvSecretV2Write writes to.
+func kvSecretV2CapabilityPaths(d *schema.ResourceDiff) ([]string, error) {
+	if !d.NewValueKnown(consts.FieldMount) || !d.NewValueKnown(consts.FieldName) {
+		return nil, nil
+	}
+
+	mount := d.Get(consts.FieldMount).(string)
+	name := d.Get(consts.FieldName).(string)
+
+	paths := []string{getKVV2Path(mount, name, consts.FieldData)}
+	if _, ok := d.GetOk(consts.FieldCustomMetadata); ok {
+		paths = append(paths, getKVV2Path(mount, name, consts.FieldMetadata))
+	}
+
+	return paths, nil
+}
+
+// kvSecretV2LastWrittenVersion returns the version that was last written by
+// the resource, or the version that was last read for resources that were
+// imported or written before auto_cas was enabled.
+func kvSecretV2LastWrittenVersion(d *schema.ResourceData) int {
+	if v := d.Get(consts.FieldLastWrittenVersion).(int); v > 0 {
+		return v
+	}
+
+	metadata := d.Get(consts.FieldMetadata).(map[string]interface{})
+	if v, ok := metadata[consts.FieldVersion].(string); ok {
+		if version, err := strconv.Atoi(v); err == nil {
+			return version
+		}
+	}
+
+	return 0
+}
+
+// kvSecretV2Patch merges the secret data with the KV-V2 patch endpoint. The
+// keys that were removed from data_json are set to null so that they are
+// removed from the secret, all other keys of the secret are left unchanged.
+// Secrets that do not exist yet cannot be patched, they are written instead.
+// With auto_cas, an existing secret is patched at the version that was read.
+func kvSecretV2Patch(ctx context.Context, client *api.Client, d *schema.ResourceData, path string, data map[string]interface{}) (*api.Secret, error) {
+	if d.IsNewResource() {
+		existing, err := client.Logical().ReadWithContext(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+
+		if existing == nil || existing.Data[consts.FieldData] == nil {
+			log.Printf("[DEBUG] Secret %q does not exist, writing it instead of patching it", path)
+			return util.RetryWrite(client, path, data, util.DefaultRequestOpts())
+		}
+
+		if d.Get(consts.FieldAutoCAS).(bool) {
+			metadata, _ := existing.Data[consts.FieldMetadata].(map[string]interface{})
+			if v, ok := metadata[consts.FieldVersion]; ok {
+				data[consts.FieldOptions].(map[string]interface{})[consts.FieldCAS] = v
+			}
+		}
+	}
+
+	secretData := data[consts.FieldData].(map[string]interface{})
+	if d.HasChange(consts.FieldDataJSON) {
+		o, _ := d.GetChange(consts.FieldDataJSON)
+		var oldData map[string]interface{}
+		if v := o.(string); v != "" {
+			if err := json.Unmarshal([]byte(v), &oldData); err != nil {
+				return nil, err
+			}
+		}
+
+		for k := range oldData {
+			if _, ok := secretData[k]; !ok {
+				secretData[k] = nil
+			}
+		}
+	}
+
+	patch := map[string]interface{}{
+		consts.FieldData:    secretData,
+		consts.FieldOptions: data[consts.FieldOptions],
+	}
+
+	log.Printf("[DEBUG] Patching secret at %q", path)
+	return client.Logical().JSONMergePatch(ctx, path, patch)
+}
+
+// isKVV2CASMismatch returns true if the write failed because the cas did not
+// match the current version of the secret.
+func isKVV2CASMismatch(err error) bool {
+	return strings.Contains(err.Error(), "check-and-set parameter did not match the current version")
+}
+
+func kvSecretV2CASMismatchDiag(client *api.Client, mount, name string, casVersion int) diag.Diagnostics {
+	metadataPath := getKVV2Path(mount, name, consts.FieldMetadata)
+	summary := fmt.Sprintf("secret %q was written outside of Terraform", getKVV2Path(mount, name, consts.FieldData))
+
+	resp, err := client.Logical().Read(metadataPath)
+	if err != nil || resp == nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  summary,
+				Detail: fmt.Sprintf("The check-and-set version %d does not match the current version "+
+					"of the secret.", casVersion),
+			},
+		}
+	}
+
+	if casVersion == 0 {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  summary,
+				Detail: fmt.Sprintf("The secret already exists at version %v. Import it, or to "+
+					"overwrite it apply once with auto_cas disabled.", resp.Data[consts.FieldCurrentVersion]),
+			},
+		}
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  summary,
+			Detail: fmt.Sprintf("The secret was last written by Terraform at version %d, but its "+
+				"current version is %v. Review the out-of-band changes, to overwrite them "+
+				"apply once with auto_cas disabled.", casVersion, resp.Data[consts.FieldCurrentVersion]),
+		},
+	}
+}
+
 func getCustomMetadata(d *schema.ResourceData) map[string]interface{} {
 	data := map[string]interface{}{}
 
@@ -236,17 +395,51 @@ func kvSecretV2Write(ctx context.Context, d *schema.ResourceData, meta interface
 		"data": secretData,
 	}
 
-	kvFields := []string{"cas", "options"}
+	kvFields := []string{consts.FieldCAS, consts.FieldOptions}
 	for _, k := range kvFields {
 		data[k] = d.Get(k)
 	}
 
-	if _, err := util.RetryWrite(client, path, data, util.DefaultRequestOpts()); err != nil {
+	var casVersion int
+	autoCAS := d.Get(consts.FieldAutoCAS).(bool)
+	if autoCAS {
+		// KV-V2 only honors the cas that is set in the options, on create the
+		// cas is 0 so that existing secrets are not overwritten.
+		casVersion = kvSecretV2LastWrittenVersion(d)
+		options := map[string]interface{}{
+			consts.FieldCAS: casVersion,
+		}
+		for k, v := range d.Get(consts.FieldOptions).(map[string]interface{}) {
+			if k != consts.FieldCAS {
+				options[k] = v
+			}
+		}
+		data[consts.FieldOptions] = options
+	}
+
+	var resp *api.Secret
+	if d.Get(consts.FieldWriteMode).(string) == kvV2WriteModePatch {
+		resp, err = kvSecretV2Patch(ctx, client, d, path, data)
+	} else {
+		resp, err = util.RetryWrite(client, path, data, util.DefaultRequestOpts())
+	}
+	if err != nil {
+		if autoCAS && isKVV2CASMismatch(err) {
+			return kvSecretV2CASMismatchDiag(client, mount, name, casVersion)
+		}
 		return diag.FromErr(err)
 	}
 
 	d.SetId(path)
 
+	if resp != nil {
+		if v, ok := resp.Data[consts.FieldVersion]; ok {
+			if err := d.Set(consts.FieldLastWrittenVersion, v); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	// Write custom metadata for secret if provided
 	if _, ok := d.GetOk(consts.FieldCustomMetadata); ok {
 		cm := getCustomMetadata(d)
@@ -376,7 +569,7 @@ func readKVV2Metadata(client *api.Client, path string) (map[string]interface{},
 	return data, nil
 }
 
-func kvSecretV2Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func kvSecretV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, e := provider.GetClient(d, meta)
 	if e != nil {
 		return diag.FromErr(e)
@@ -389,6 +582,8 @@ func kvSecretV2Delete(_ context.Context, d *schema.ResourceData, meta interface{
 	deleteAllVersions := d.Get("delete_all_versions").(bool)
 	if deleteAllVersions {
 		base = consts.FieldMetadata
+	} else if d.Get(consts.FieldWriteMode).(string) == kvV2WriteModePatch {
+		return kvSecretV2DeletePatchedKeys(ctx, client, d, getKVV2Path(mount, name, base))
 	}
 
 	path := getKVV2Path(mount, name, base)
@@ -402,6 +597,35 @@ func kvSecretV2Delete(_ context.Context, d *schema.ResourceData, meta interface{
 	return nil
 }
 
+// kvSecretV2DeletePatchedKeys removes the keys that are managed by the
+// resource from the secret, and leaves all other keys unchanged.
+func kvSecretV2DeletePatchedKeys(ctx context.Context, client *api.Client, d *schema.ResourceData, path string) diag.Diagnostics {
+	v := d.Get(consts.FieldDataJSON).(string)
+	if v == "" {
+		log.Printf("[WARN] The keys of %q are write-only, leaving the secret unchanged", path)
+		return nil
+	}
+
+	var secretData map[string]interface{}
+	if err := json.Unmarshal([]byte(v), &secretData); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for k := range secretData {
+		secretData[k] = nil
+	}
+
+	log.Printf("[DEBUG] Removing the managed keys from %q", path)
+	_, err := client.Logical().JSONMergePatch(ctx, path, map[string]interface{}{
+		consts.FieldData: secretData,
+	})
+	if err != nil && !util.Is404(err) {
+		return diag.Errorf("error removing the managed keys from %q: %s", path, err)
+	}
+
+	return nil
+}
+
 func getKVV2SecretNameFromPath(path string) (string, error) {
 	if !kvV2SecretNameFromPathRegex.MatchString(path) {
 		return "", fmt.Errorf("no name found")
@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func kvSecretV2RollbackResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: kvSecretV2RollbackWrite,
+		UpdateContext: kvSecretV2RollbackWrite,
+		DeleteContext: kvSecretV2RollbackDelete,
+		ReadContext:   provider.ReadContextWrapper(kvSecretV2RollbackRead),
+		CustomizeDiff: kvSecretV2RollbackDiff,
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldMount: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path where KV-V2 engine is mounted.",
+			},
+			consts.FieldName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Full name of the secret. For a nested secret, " +
+					"the name is the nested path excluding the mount and data " +
+					"prefix.",
+			},
+			consts.FieldVersion: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "The version of the secret to write as the current version.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			consts.FieldCurrentVersion: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version that was written by the rollback.",
+			},
+		},
+	}
+}
+
+// kvSecretV2RollbackDiff plans the rollback again once the version that it
+// wrote is no longer the current version of the secret.
+func kvSecretV2RollbackDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || d.Get(consts.FieldCurrentVersion).(int) != 0 {
+		return nil
+	}
+
+	return d.SetNewComputed(consts.FieldCurrentVersion)
+}
+
+func kvSecretV2RollbackWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	mount := d.Get(consts.FieldMount).(string)
+	name := d.Get(consts.FieldName).(string)
+	version := d.Get(consts.FieldVersion).(int)
+
+	path := getKVV2Path(mount, name, consts.FieldData)
+
+	log.Printf("[DEBUG] Reading version %d of %q", version, name)
+	secret, err := kvReadRequest(client, path, map[string]string{
+		consts.FieldVersion: strconv.Itoa(version),
+	})
+	if err != nil {
+		return diag.Errorf("error reading version %d of %q: %s", version, name, err)
+	}
+
+	if secret == nil || secret.Data == nil {
+		return diag.Errorf("version %d of %q not found", version, name)
+	}
+	// the data of deleted and destroyed versions is null
+	versionData, ok := secret.Data[consts.FieldData].(map[string]interface{})
+	if !ok {
+		return diag.Errorf("version %d of %q is deleted or destroyed", version, name)
+	}
+
+	data := map[string]interface{}{
+		consts.FieldData: versionData,
+	}
+
+	casVersion, casRequired, err := kvV2RollbackCAS(ctx, client, mount, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if casRequired {
+		data[consts.FieldOptions] = map[string]interface{}{
+			consts.FieldCAS: casVersion,
+		}
+	}
+
+	log.Printf("[DEBUG] Writing version %d of %q as the current version", version, name)
+	resp, err := util.RetryWrite(client, path, data, util.DefaultRequestOpts())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(path)
+
+	if resp != nil {
+		if v, ok := resp.Data[consts.FieldVersion]; ok {
+			if err := d.Set(consts.FieldCurrentVersion, v); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return kvSecretV2RollbackRead(ctx, d, meta)
+}
+
+func kvSecretV2RollbackRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	mount := d.Get(consts.FieldMount).(string)
+	name := d.Get(consts.FieldName).(string)
+	path := getKVV2Path(mount, name, consts.FieldMetadata)
+
+	log.Printf("[DEBUG] Reading metadata from %q", path)
+	resp, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return diag.Errorf("error reading from %q: %s", path, err)
+	}
+
+	if resp == nil {
+		log.Printf("[WARN] metadata (%s) not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	// the secret was written to since the rollback, clearing the version
+	// plans the rollback again.
+	if v, ok := resp.Data[consts.FieldCurrentVersion].(json.Number); ok {
+		current, err := v.Int64()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if int(current) != d.Get(consts.FieldCurrentVersion).(int) {
+			log.Printf("[WARN] Version %d of %q is no longer the current version",
+				d.Get(consts.FieldCurrentVersion), name)
+			if err := d.Set(consts.FieldCurrentVersion, nil); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// kvV2RollbackCAS returns the current version of the secret, and whether
+// writes to the secret require it as the cas option, either because of the
+// secret's metadata or because of the configuration of the mount.
+func kvV2RollbackCAS(ctx context.Context, client *api.Client, mount, name string) (int, bool, error) {
+	path := getKVV2Path(mount, name, consts.FieldMetadata)
+	resp, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return 0, false, fmt.Errorf("error reading from %q: %w", path, err)
+	}
+	if resp == nil {
+		return 0, false, fmt.Errorf("metadata of %q not found", name)
+	}
+
+	current, err := kvV2MetadataVersion(resp.Data, consts.FieldCurrentVersion)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if v, _ := resp.Data[consts.FieldCASRequired].(bool); v {
+		return current, true, nil
+	}
+
+	configPath := strings.Trim(mount, "/") + "/config"
+	config, err := client.Logical().ReadWithContext(ctx, configPath)
+	if err != nil {
+		return 0, false, fmt.Errorf("error reading from %q: %w", configPath, err)
+	}
+	if config == nil {
+		return current, false, nil
+	}
+
+	v, _ := config.Data[consts.FieldCASRequired].(bool)
+	return current, v, nil
+}
+
+func kvSecretV2RollbackDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing %q from state, the secret is left unchanged", d.Id())
+	return nil
+}
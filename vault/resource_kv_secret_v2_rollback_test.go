@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccKVSecretV2Rollback(t *testing.T) {
+	resourceName := "vault_kv_secret_v2_rollback.test"
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("tf-secret")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKVSecretV2RollbackConfig(mount, name, "v1", 0),
+			},
+			{
+				Config: testKVSecretV2RollbackConfig(mount, name, "v2", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldVersion, "1"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldCurrentVersion, "3"),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/data/%s", mount, name)),
+					assertKVDataEquals(mount, name, map[string]interface{}{"foo": "v1"}),
+				),
+			},
+			{
+				Config: testKVSecretV2RollbackConfig(mount, name, "v2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldVersion, "2"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldCurrentVersion, "4"),
+					assertKVDataEquals(mount, name, map[string]interface{}{"foo": "v2"}),
+				),
+			},
+			{
+				// writing another version plans the rollback again
+				PreConfig: func() {
+					writeKVData(t, mount, name)
+				},
+				Config: testKVSecretV2RollbackConfig(mount, name, "v2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldVersion, "2"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldCurrentVersion, "6"),
+					assertKVDataEquals(mount, name, map[string]interface{}{"foo": "v2"}),
+				),
+			},
+			{
+				Config:      testKVSecretV2RollbackConfig(mount, name, "v2", 10),
+				ExpectError: regexp.MustCompile("version 10 of .* not found"),
+			},
+		},
+	})
+}
+
+func testKVSecretV2RollbackConfig(mount, name, value string, version int) string {
+	ret := fmt.Sprintf(`
+%s
+
+resource "vault_kv_secret_v2" "test" {
+  mount        = vault_mount.kvv2.path
+  name         = "%s"
+  disable_read = true
+  data_json = jsonencode({
+    foo = "%s"
+  })
+}
+`, kvV2MountConfig(mount), name, value)
+
+	if version > 0 {
+		ret += fmt.Sprintf(`
+resource "vault_kv_secret_v2_rollback" "test" {
+  mount   = vault_mount.kvv2.path
+  name    = vault_kv_secret_v2.test.name
+  version = %d
+}
+`, version)
+	}
+
+	return ret
+}
+
+func TestKVV2RollbackCAS(t *testing.T) {
+	tests := []struct {
+		name           string
+		secretRequired bool
+		mountRequired  bool
+		want           bool
+	}{
+		{
+			name: "not-required",
+		},
+		{
+			name:           "secret",
+			secretRequired: true,
+			want:           true,
+		},
+		{
+			name:          "mount",
+			mountRequired: true,
+			want:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var data map[string]interface{}
+				switch r.URL.Path {
+				case "/v1/kvv2/metadata/foo":
+					data = map[string]interface{}{
+						consts.FieldCurrentVersion: 3,
+						consts.FieldCASRequired:    tt.secretRequired,
+					}
+				case "/v1/kvv2/config":
+					data = map[string]interface{}{
+						consts.FieldCASRequired: tt.mountRequired,
+					}
+				default:
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+			})
+
+			config, ln := testutil.TestHTTPServer(t, handler)
+			defer ln.Close()
+
+			client, err := api.NewClient(config)
+			require.NoError(t, err)
+
+			version, required, err := kvV2RollbackCAS(context.Background(), client, "kvv2", "foo")
+			require.NoError(t, err)
+			assert.Equal(t, 3, version)
+			assert.Equal(t, tt.want, required)
+		})
+	}
+}
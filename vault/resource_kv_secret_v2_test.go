@@ -9,8 +9,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"reflect"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/vault/api"
@@ -68,6 +70,44 @@ func TestAccKVSecretV2_pathRegex(t *testing.T) {
 	}
 }
 
+func TestKVSecretV2LastWrittenVersion(t *testing.T) {
+	tests := map[string]struct {
+		raw  map[string]interface{}
+		meta map[string]interface{}
+		want int
+	}{
+		"written": {
+			raw:  map[string]interface{}{consts.FieldLastWrittenVersion: 4},
+			meta: map[string]interface{}{consts.FieldVersion: "5"},
+			want: 4,
+		},
+		"imported": {
+			meta: map[string]interface{}{consts.FieldVersion: "5"},
+			want: 5,
+		},
+		"empty": {
+			want: 0,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, kvSecretV2Resource("vault_kv_secret_v2").Schema, map[string]interface{}{})
+			if v, ok := tc.raw[consts.FieldLastWrittenVersion]; ok {
+				if err := d.Set(consts.FieldLastWrittenVersion, v); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := d.Set(consts.FieldMetadata, tc.meta); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := kvSecretV2LastWrittenVersion(d); got != tc.want {
+				t.Fatalf("expected version %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
 func TestAccKVSecretV2(t *testing.T) {
 	t.Parallel()
 	resourceName := "vault_kv_secret_v2.test"
@@ -209,6 +249,109 @@ func TestAccKVSecretV2_DisableRead(t *testing.T) {
 	})
 }
 
+func TestAccKVSecretV2_AutoCAS(t *testing.T) {
+	t.Parallel()
+	resourceName := "vault_kv_secret_v2.test"
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("tf-secret")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					mountKVEngine(t, mount, name)
+				},
+				Config: testKVSecretV2Config_AutoCAS(mount, name, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldAutoCAS, "true"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldLastWrittenVersion, "1"),
+				),
+			},
+			{
+				Config: testKVSecretV2Config_AutoCAS(mount, name, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldLastWrittenVersion, "2"),
+					assertKVDataEquals(mount, name, map[string]interface{}{"foo": "v2"}),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeKVData(t, mount, name)
+				},
+				Config:      testKVSecretV2Config_AutoCAS(mount, name, "v3"),
+				ExpectError: regexp.MustCompile(`(?s)was written outside of Terraform.*version 2.*current version is 3`),
+			},
+		},
+	})
+}
+
+func TestAccKVSecretV2_AutoCASExisting(t *testing.T) {
+	t.Parallel()
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("tf-secret")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy:             assertKVDataEquals(mount, name, testKVV2Data),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					mountKVEngine(t, mount, name)
+					writeKVData(t, mount, name)
+				},
+				Config:      testKVSecretV2Config_AutoCAS(mount, name, "v1"),
+				ExpectError: regexp.MustCompile(`(?s)was written outside of Terraform.*already exists at version 1`),
+			},
+		},
+	})
+}
+
+func TestAccKVSecretV2_PatchWriteMode(t *testing.T) {
+	t.Parallel()
+	resourceName := "vault_kv_secret_v2.test"
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("tf-secret")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		CheckDestroy:             assertKVDataEquals(mount, name, testKVV2Data),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					mountKVEngine(t, mount, name)
+					writeKVData(t, mount, name)
+				},
+				Config: testKVSecretV2Config_Patch(mount, name, `{ zip = "zap", flag = "on" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldWriteMode, kvV2WriteModePatch),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldLastWrittenVersion, "2"),
+					assertKVDataEquals(mount, name, map[string]interface{}{
+						"foo":  "bar",
+						"baz":  "qux",
+						"zip":  "zap",
+						"flag": "on",
+					}),
+				),
+			},
+			{
+				Config: testKVSecretV2Config_Patch(mount, name, `{ zip = "zop" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldLastWrittenVersion, "3"),
+					assertKVDataEquals(mount, name, map[string]interface{}{
+						"foo": "bar",
+						"baz": "qux",
+						"zip": "zop",
+					}),
+				),
+			},
+		},
+	})
+}
+
 // TestAccKVSecretV2_data_json_wo ensures write-only attribute
 // `data_json_wo` works as expected
 func TestAccKVSecretV2_data_json_wo(t *testing.T) {
@@ -403,6 +546,28 @@ resource "vault_kv_secret_v2" "test" {
 }`, mount, name)
 }
 
+func testKVSecretV2Config_AutoCAS(mount, name, value string) string {
+	return fmt.Sprintf(`
+resource "vault_kv_secret_v2" "test" {
+  mount    = "%s"
+  name     = "%s"
+  auto_cas = true
+  data_json = jsonencode({
+    foo = "%s"
+  })
+}`, mount, name, value)
+}
+
+func testKVSecretV2Config_Patch(mount, name, data string) string {
+	return fmt.Sprintf(`
+resource "vault_kv_secret_v2" "test" {
+  mount      = "%s"
+  name       = "%s"
+  write_mode = "patch"
+  data_json  = jsonencode(%s)
+}`, mount, name, data)
+}
+
 func testKVSecretV2Config_initial(mount, name string) string {
 	ret := fmt.Sprintf(`
 %s
@@ -0,0 +1,283 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const (
+	kvV2VersionStateActive    = "active"
+	kvV2VersionStateDeleted   = "deleted"
+	kvV2VersionStateDestroyed = "destroyed"
+)
+
+// kvV2VersionStateOperations maps each state to the KV-V2 endpoint that
+// moves the versions into it.
+var kvV2VersionStateOperations = map[string]string{
+	kvV2VersionStateActive:    "undelete",
+	kvV2VersionStateDeleted:   "delete",
+	kvV2VersionStateDestroyed: "destroy",
+}
+
+func kvSecretV2VersionStateResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: kvSecretV2VersionStateWrite,
+		UpdateContext: kvSecretV2VersionStateWrite,
+		DeleteContext: kvSecretV2VersionStateDelete,
+		ReadContext:   provider.ReadContextWrapper(kvSecretV2VersionStateRead),
+		CustomizeDiff: kvSecretV2VersionStateDiff,
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldMount: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path where KV-V2 engine is mounted.",
+			},
+			consts.FieldName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Full name of the secret. For a nested secret, " +
+					"the name is the nested path excluding the mount and data " +
+					"prefix.",
+			},
+			consts.FieldVersions: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The versions of the secret to manage.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+			consts.FieldState: {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "The state of the versions, one of 'active', 'deleted' or 'destroyed'. " +
+					"Destroyed versions cannot be restored.",
+				ValidateFunc: validation.StringInSlice([]string{
+					kvV2VersionStateActive,
+					kvV2VersionStateDeleted,
+					kvV2VersionStateDestroyed,
+				}, false),
+			},
+			consts.FieldCurrentVersion: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The current version of the secret.",
+			},
+		},
+	}
+}
+
+func kvSecretV2VersionStateDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange(consts.FieldState) {
+		return nil
+	}
+
+	o, n := d.GetChange(consts.FieldState)
+	if o.(string) == kvV2VersionStateDestroyed && n.(string) != kvV2VersionStateDestroyed {
+		return fmt.Errorf("destroyed versions of %q cannot be restored to %q",
+			d.Get(consts.FieldName), n)
+	}
+
+	return nil
+}
+
+// kvSecretV2VersionStateCapabilityPaths returns the path that
+// kvSecretV2VersionStateWrite writes to.
+func kvSecretV2VersionStateCapabilityPaths(d *schema.ResourceDiff) ([]string, error) {
+	for _, k := range []string{consts.FieldMount, consts.FieldName, consts.FieldState} {
+		if !d.NewValueKnown(k) {
+			return nil, nil
+		}
+	}
+
+	op := kvV2VersionStateOperations[d.Get(consts.FieldState).(string)]
+	return []string{
+		getKVV2Path(d.Get(consts.FieldMount).(string), d.Get(consts.FieldName).(string), op),
+	}, nil
+}
+
+func kvSecretV2VersionStateWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	mount := d.Get(consts.FieldMount).(string)
+	name := d.Get(consts.FieldName).(string)
+	state := d.Get(consts.FieldState).(string)
+	versions := kvV2Versions(d)
+
+	// versions that do not exist yet would be reported as destroyed, so they
+	// are rejected before they are written.
+	metadataPath := getKVV2Path(mount, name, consts.FieldMetadata)
+	resp, err := client.Logical().ReadWithContext(ctx, metadataPath)
+	if err != nil {
+		return diag.Errorf("error reading from %q: %s", metadataPath, err)
+	}
+	if resp == nil {
+		return diag.Errorf("secret %q not found on mount %q", name, mount)
+	}
+	if _, err := kvV2VersionsState(resp.Data, versions, time.Now()); err != nil {
+		return diag.Errorf("error checking the versions of %q: %s", name, err)
+	}
+
+	path := getKVV2Path(mount, name, kvV2VersionStateOperations[state])
+	data := map[string]interface{}{
+		consts.FieldVersions: versions,
+	}
+
+	log.Printf("[DEBUG] Setting versions %v of %q to %q", versions, name, state)
+	if _, err := client.Logical().WriteWithContext(ctx, path, data); err != nil {
+		return diag.Errorf("error writing to %q: %s", path, err)
+	}
+
+	d.SetId(metadataPath)
+
+	return kvSecretV2VersionStateRead(ctx, d, meta)
+}
+
+func kvSecretV2VersionStateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading metadata from %q", path)
+	resp, err := client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return diag.Errorf("error reading from %q: %s", path, err)
+	}
+
+	if resp == nil {
+		log.Printf("[WARN] metadata (%s) not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	state, err := kvV2VersionsState(resp.Data, kvV2Versions(d), time.Now())
+	if err != nil {
+		return diag.Errorf("error reading the versions of %q: %s", d.Get(consts.FieldName), err)
+	}
+
+	if err := d.Set(consts.FieldState, state); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if v, ok := resp.Data[consts.FieldCurrentVersion]; ok {
+		if err := d.Set(consts.FieldCurrentVersion, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func kvSecretV2VersionStateDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing %q from state, the state of its versions is left unchanged", d.Id())
+	return nil
+}
+
+// kvV2Versions returns the sorted versions of the resource.
+func kvV2Versions(d *schema.ResourceData) []int {
+	var versions []int
+	for _, v := range d.Get(consts.FieldVersions).(*schema.Set).List() {
+		versions = append(versions, v.(int))
+	}
+	sort.Ints(versions)
+
+	return versions
+}
+
+// kvV2VersionsState returns the state that is shared by all the versions in
+// the secret's metadata. An empty state is returned if the versions are in
+// different states. Versions below oldest_version were removed because of
+// max_versions, they cannot be restored and are considered destroyed. An error
+// is returned for versions that do not exist yet.
+func kvV2VersionsState(metadata map[string]interface{}, versions []int, now time.Time) (string, error) {
+	current, err := kvV2MetadataVersion(metadata, consts.FieldCurrentVersion)
+	if err != nil {
+		return "", err
+	}
+	oldest, err := kvV2MetadataVersion(metadata, consts.FieldOldestVersion)
+	if err != nil {
+		return "", err
+	}
+
+	versionsMetadata, _ := metadata[consts.FieldVersions].(map[string]interface{})
+
+	var state string
+	for _, v := range versions {
+		var versionState string
+		if v > current {
+			return "", fmt.Errorf("version %d does not exist, the current version is %d", v, current)
+		} else if m, ok := versionsMetadata[strconv.Itoa(v)].(map[string]interface{}); ok {
+			versionState = kvV2VersionMetadataState(m, now)
+		} else if v < oldest {
+			versionState = kvV2VersionStateDestroyed
+		} else {
+			return "", fmt.Errorf("version %d not found in the metadata", v)
+		}
+
+		if state != "" && state != versionState {
+			return "", nil
+		}
+		state = versionState
+	}
+
+	return state, nil
+}
+
+// kvV2MetadataVersion returns the version in the key of the secret's metadata,
+// or 0 if it is not set.
+func kvV2MetadataVersion(metadata map[string]interface{}, key string) (int, error) {
+	v, ok := metadata[key].(json.Number)
+	if !ok {
+		return 0, nil
+	}
+
+	n, err := v.Int64()
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", key, v, err)
+	}
+
+	return int(n), nil
+}
+
+func kvV2VersionMetadataState(m map[string]interface{}, now time.Time) string {
+	if destroyed, _ := m[consts.FieldDestroyed].(bool); destroyed {
+		return kvV2VersionStateDestroyed
+	}
+
+	// the deletion time is in the future for versions that are deleted
+	// automatically after delete_version_after
+	if v, _ := m[consts.FieldDeletionTime].(string); v != "" {
+		deletionTime, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil || !deletionTime.After(now) {
+			return kvV2VersionStateDeleted
+		}
+	}
+
+	return kvV2VersionStateActive
+}
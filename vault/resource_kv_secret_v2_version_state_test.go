@@ -0,0 +1,194 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestKVV2VersionsState(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	versions := map[string]interface{}{
+		"1": map[string]interface{}{
+			consts.FieldDeletionTime: "",
+			consts.FieldDestroyed:    true,
+		},
+		"2": map[string]interface{}{
+			consts.FieldDeletionTime: "2025-12-31T00:00:00.000000Z",
+			consts.FieldDestroyed:    false,
+		},
+		"3": map[string]interface{}{
+			consts.FieldDeletionTime: "2025-12-30T00:00:00.000000Z",
+			consts.FieldDestroyed:    false,
+		},
+		"4": map[string]interface{}{
+			consts.FieldDeletionTime: "",
+			consts.FieldDestroyed:    false,
+		},
+		"5": map[string]interface{}{
+			consts.FieldDeletionTime: "2026-01-02T00:00:00.000000Z",
+			consts.FieldDestroyed:    false,
+		},
+		"7": map[string]interface{}{
+			consts.FieldDeletionTime: "",
+			consts.FieldDestroyed:    false,
+		},
+	}
+	// version 6 is missing from the metadata
+	metadata := map[string]interface{}{
+		consts.FieldCurrentVersion: json.Number("7"),
+		consts.FieldOldestVersion:  json.Number("1"),
+		consts.FieldVersions:       versions,
+	}
+
+	tests := []struct {
+		name     string
+		metadata map[string]interface{}
+		versions []int
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "destroyed",
+			versions: []int{1},
+			want:     kvV2VersionStateDestroyed,
+		},
+		{
+			name: "pruned",
+			metadata: map[string]interface{}{
+				consts.FieldCurrentVersion: json.Number("12"),
+				consts.FieldOldestVersion:  json.Number("11"),
+				consts.FieldVersions:       map[string]interface{}{},
+			},
+			versions: []int{10},
+			want:     kvV2VersionStateDestroyed,
+		},
+		{
+			name:     "future",
+			versions: []int{4, 8},
+			wantErr:  true,
+		},
+		{
+			name:     "missing",
+			versions: []int{6},
+			wantErr:  true,
+		},
+		{
+			name:     "deleted",
+			versions: []int{2, 3},
+			want:     kvV2VersionStateDeleted,
+		},
+		{
+			name:     "active",
+			versions: []int{4},
+			want:     kvV2VersionStateActive,
+		},
+		{
+			name:     "scheduled-deletion",
+			versions: []int{4, 5},
+			want:     kvV2VersionStateActive,
+		},
+		{
+			name:     "mixed",
+			versions: []int{3, 4},
+			want:     "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := metadata
+			if tt.metadata != nil {
+				m = tt.metadata
+			}
+
+			got, err := kvV2VersionsState(m, tt.versions, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("kvV2VersionsState() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("kvV2VersionsState() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccKVSecretV2VersionState(t *testing.T) {
+	resourceName := "vault_kv_secret_v2_version_state.test"
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("tf-secret")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKVSecretV2VersionStateConfig(mount, name, "v1", ""),
+			},
+			{
+				Config: testKVSecretV2VersionStateConfig(mount, name, "v2", kvV2VersionStateDeleted),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldState, kvV2VersionStateDeleted),
+					resource.TestCheckResourceAttr(resourceName, "versions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldCurrentVersion, "2"),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/metadata/%s", mount, name)),
+				),
+			},
+			{
+				Config: testKVSecretV2VersionStateConfig(mount, name, "v2", kvV2VersionStateActive),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldState, kvV2VersionStateActive),
+				),
+			},
+			{
+				Config: testKVSecretV2VersionStateConfig(mount, name, "v2", kvV2VersionStateDestroyed),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldState, kvV2VersionStateDestroyed),
+				),
+			},
+			{
+				Config:      testKVSecretV2VersionStateConfig(mount, name, "v2", kvV2VersionStateActive),
+				ExpectError: regexp.MustCompile("cannot be restored"),
+			},
+		},
+	})
+}
+
+func testKVSecretV2VersionStateConfig(mount, name, value, state string) string {
+	ret := fmt.Sprintf(`
+%s
+
+resource "vault_kv_secret_v2" "test" {
+  mount        = vault_mount.kvv2.path
+  name         = "%s"
+  disable_read = true
+  data_json = jsonencode({
+    foo = "%s"
+  })
+}
+`, kvV2MountConfig(mount), name, value)
+
+	if state != "" {
+		ret += fmt.Sprintf(`
+resource "vault_kv_secret_v2_version_state" "test" {
+  mount    = vault_mount.kvv2.path
+  name     = vault_kv_secret_v2.test.name
+  versions = [1]
+  state    = "%s"
+}
+`, state)
+	}
+
+	return ret
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/pki"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
 	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/terraform-provider-vault/util/mountutil"
@@ -422,7 +423,7 @@ func pkiSecretBackendCertUpdate(ctx context.Context, d *schema.ResourceData, m i
 	return nil
 }
 
-func pkiSecretBackendCertDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func pkiSecretBackendCertDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var revokeWithKey bool
 	if d.Get(consts.FieldRevokeWithKey) != nil {
 		revokeWithKey = d.Get(consts.FieldRevokeWithKey).(bool)
@@ -438,20 +439,14 @@ func pkiSecretBackendCertDelete(_ context.Context, d *schema.ResourceData, meta
 		backend := d.Get(consts.FieldBackend).(string)
 		serialNumber := d.Get(consts.FieldSerialNumber).(string)
 		commonName := d.Get(consts.FieldCommonName).(string)
-		data := map[string]interface{}{
-			consts.FieldSerialNumber: serialNumber,
-		}
-		var path string
+		var privateKey string
 		if revokeWithKey {
-			data["private_key"] = d.Get(consts.FieldPrivateKey).(string)
-			path = strings.Trim(backend, "/") + "/revoke-with-key"
-		} else {
-			path = strings.Trim(backend, "/") + "/revoke"
+			privateKey = d.Get(consts.FieldPrivateKey).(string)
 		}
 
 		log.Printf("[DEBUG] Revoking certificate %q with serial number %q on PKI secret backend %q",
 			commonName, serialNumber, backend)
-		_, err := client.Logical().Write(path, data)
+		err := pki.RevokeCertificate(ctx, client, backend, serialNumber, privateKey)
 
 		if err != nil {
 			return diag.Errorf("error revoking certificate %q with serial number %q for PKI secret backend %q: %s",
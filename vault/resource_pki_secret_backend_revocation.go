@@ -0,0 +1,237 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/pki"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const (
+	fieldRevokedCerts = "revoked_certs"
+	fieldInCRL        = "in_crl"
+)
+
+var pkiSerialNumberRegexp = regexp.MustCompile(`^[0-9a-f]{2}(:[0-9a-f]{2})*$`)
+
+// pkiRevocation is the revocation status of a certificate.
+type pkiRevocation struct {
+	SerialNumber   string
+	RevocationTime int64
+	InCRL          bool
+}
+
+func pkiSecretBackendRevocationResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: pkiSecretBackendRevocationWrite,
+		UpdateContext: pkiSecretBackendRevocationWrite,
+		ReadContext:   provider.ReadContextWrapper(pkiSecretBackendRevocationRead),
+		DeleteContext: pkiSecretBackendRevocationDelete,
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldBackend: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Full path where PKI backend is mounted.",
+			},
+			fieldSerialNumbers: {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringMatch(pkiSerialNumberRegexp,
+						"must be colon-separated lowercase hex bytes, e.g. 17:5e:2a"),
+				},
+				Description: "Serial numbers of the certificates to revoke.",
+			},
+			fieldRevokedCerts: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Revocation status of the certificates, sorted by serial number.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						consts.FieldSerialNumber: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The certificate serial number.",
+						},
+						fieldRevocationTime: {
+							Type:     schema.TypeInt,
+							Computed: true,
+							Description: "The revocation time as a Unix-style timestamp, " +
+								"0 if the certificate was removed by a tidy operation.",
+						},
+						fieldInCRL: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "True if the certificate is in the CRL of its issuer.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func pkiSecretBackendRevocationWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := d.Get(consts.FieldBackend).(string)
+	o, n := d.GetChange(fieldSerialNumbers)
+	serials := expandStringSlice(n.(*schema.Set).List())
+	// the certificates in the prior state were found revoked by the last read,
+	// and may have been removed by a tidy operation since
+	revoked := expandStringSlice(o.(*schema.Set).List())
+	if err := revokePKICertificates(ctx, client, backend, serials, revoked); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/revoke", backend))
+
+	return pkiSecretBackendRevocationRead(ctx, d, meta)
+}
+
+func pkiSecretBackendRevocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	backend := d.Get(consts.FieldBackend).(string)
+	serials := expandStringSlice(d.Get(fieldSerialNumbers).(*schema.Set).List())
+	revocations, err := readPKIRevocations(ctx, client, backend, serials)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// certificates that are not revoked anymore, e.g. after a restore, are
+	// removed from the state so that they are revoked again
+	revoked := make([]string, 0, len(revocations))
+	revokedCerts := make([]map[string]interface{}, 0, len(revocations))
+	for _, r := range revocations {
+		revoked = append(revoked, r.SerialNumber)
+		revokedCerts = append(revokedCerts, map[string]interface{}{
+			consts.FieldSerialNumber: r.SerialNumber,
+			fieldRevocationTime:      r.RevocationTime,
+			fieldInCRL:               r.InCRL,
+		})
+	}
+
+	if err := d.Set(fieldSerialNumbers, revoked); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(fieldRevokedCerts, revokedCerts); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// pkiSecretBackendRevocationDelete only removes the resource from the state,
+// since a revocation cannot be undone.
+func pkiSecretBackendRevocationDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing certificate revocation %q from state", d.Id())
+	return nil
+}
+
+// revokePKICertificates revokes the certificates that are not revoked yet.
+// The certificates in revoked are known to be revoked and are skipped. All the
+// other certificates are checked before any is revoked, so that a typo in a
+// serial number does not leave the revocation half done.
+func revokePKICertificates(ctx context.Context, client *api.Client, backend string, serials, revoked []string) error {
+	sort.Strings(serials)
+
+	skip := make(map[string]bool, len(revoked))
+	for _, serial := range revoked {
+		skip[serial] = true
+	}
+
+	var revoke []string
+	for _, serial := range serials {
+		if skip[serial] {
+			continue
+		}
+
+		cert, err := pki.ReadCertificate(ctx, client, backend, serial)
+		if err != nil {
+			return err
+		}
+		if cert == nil {
+			return fmt.Errorf("certificate %q not found on PKI secret backend %q", serial, backend)
+		}
+		if cert.RevocationTime == 0 {
+			revoke = append(revoke, serial)
+		}
+	}
+
+	for _, serial := range revoke {
+		log.Printf("[DEBUG] Revoking certificate %q on PKI secret backend %q", serial, backend)
+		if err := pki.RevokeCertificate(ctx, client, backend, serial, ""); err != nil {
+			return fmt.Errorf("error revoking certificate %q on PKI secret backend %q: %w", serial, backend, err)
+		}
+		log.Printf("[DEBUG] Revoked certificate %q on PKI secret backend %q", serial, backend)
+	}
+
+	return nil
+}
+
+// readPKIRevocations returns the revocation status of the revoked
+// certificates among serials, sorted by serial number. The certificates that
+// were removed by a tidy operation are considered revoked, since only expired
+// certificates are removed.
+func readPKIRevocations(ctx context.Context, client *api.Client, backend string, serials []string) ([]pkiRevocation, error) {
+	sort.Strings(serials)
+
+	crls := map[string]map[string]bool{}
+	var result []pkiRevocation
+	for _, serial := range serials {
+		cert, err := pki.ReadCertificate(ctx, client, backend, serial)
+		if err != nil {
+			return nil, err
+		}
+		if cert == nil {
+			log.Printf("[DEBUG] Certificate %q not found on PKI secret backend %q, assuming it was tidied", serial, backend)
+			result = append(result, pkiRevocation{SerialNumber: serial})
+			continue
+		}
+		if cert.RevocationTime == 0 {
+			log.Printf("[WARN] Certificate %q on PKI secret backend %q is not revoked", serial, backend)
+			continue
+		}
+
+		crl, ok := crls[cert.IssuerID]
+		if !ok {
+			crl, err = pki.ReadRevokedSerialNumbers(ctx, client, backend, cert.IssuerID)
+			if err != nil {
+				log.Printf("[WARN] Unable to read the CRL of issuer %q: %s", cert.IssuerID, err)
+			}
+			crls[cert.IssuerID] = crl
+		}
+
+		result = append(result, pkiRevocation{
+			SerialNumber:   serial,
+			RevocationTime: cert.RevocationTime,
+			InCRL:          crl[serial],
+		})
+	}
+
+	return result, nil
+}
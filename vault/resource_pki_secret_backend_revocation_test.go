@@ -0,0 +1,261 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestAccPKISecretBackendRevocation(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-pki")
+	resourceName := "vault_pki_secret_backend_revocation.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck:                 func() { testutil.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPKISecretBackendRevocationConfig(backend, "vault_pki_secret_backend_cert.a.serial_number"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldBackend, backend),
+					resource.TestCheckResourceAttr(resourceName, fieldSerialNumbers+".#", "1"),
+					resource.TestCheckResourceAttr(resourceName, fieldRevokedCerts+".#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, fieldRevokedCerts+".0."+fieldRevocationTime),
+					resource.TestCheckResourceAttr(resourceName, fieldRevokedCerts+".0."+fieldInCRL, "true"),
+				),
+			},
+			{
+				Config: testAccPKISecretBackendRevocationConfig(backend,
+					"vault_pki_secret_backend_cert.a.serial_number", "vault_pki_secret_backend_cert.b.serial_number"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, fieldSerialNumbers+".#", "2"),
+					resource.TestCheckResourceAttr(resourceName, fieldRevokedCerts+".#", "2"),
+					resource.TestCheckResourceAttr(resourceName, fieldRevokedCerts+".0."+fieldInCRL, "true"),
+					resource.TestCheckResourceAttr(resourceName, fieldRevokedCerts+".1."+fieldInCRL, "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPKISecretBackendRevocationConfig(backend string, serials ...string) string {
+	return testAccPKISecretBackendCertsBaseConfig(backend) + fmt.Sprintf(`
+resource "vault_pki_secret_backend_revocation" "test" {
+  backend        = vault_mount.test.path
+  serial_numbers = [%s]
+}
+`, strings.Join(serials, ", "))
+}
+
+func testAccPKISecretBackendCertsBaseConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path                      = "%s"
+  type                      = "pki"
+  default_lease_ttl_seconds = 3600
+  max_lease_ttl_seconds     = 86400
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test.path
+  type        = "internal"
+  common_name = "my.domain"
+  ttl         = "86400"
+  key_type    = "ec"
+  key_bits    = 256
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend          = vault_pki_secret_backend_root_cert.test.backend
+  name             = "test"
+  allowed_domains  = ["test.my.domain"]
+  allow_subdomains = true
+  max_ttl          = "3600"
+}
+
+resource "vault_pki_secret_backend_cert" "a" {
+  backend     = vault_pki_secret_backend_role.test.backend
+  name        = vault_pki_secret_backend_role.test.name
+  common_name = "a.test.my.domain"
+  ttl         = "1h"
+}
+
+resource "vault_pki_secret_backend_cert" "b" {
+  backend     = vault_pki_secret_backend_role.test.backend
+  name        = vault_pki_secret_backend_role.test.name
+  common_name = "b.test.my.domain"
+  ttl         = "1h"
+}
+`, backend)
+}
+
+// testPKIServer serves the certificates and the CRL of a single issuer, and
+// records the revoked serial numbers.
+type testPKIServer struct {
+	t       *testing.T
+	caCert  *x509.Certificate
+	caKey   *ecdsa.PrivateKey
+	certs   map[string][]byte
+	revoked map[string]int64
+	inCRL   map[string]bool
+}
+
+func newTestPKIServer(t *testing.T, serials ...int64) *testPKIServer {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	s := &testPKIServer{
+		t:       t,
+		caCert:  caCert,
+		caKey:   key,
+		certs:   map[string][]byte{},
+		revoked: map[string]int64{},
+		inCRL:   map[string]bool{},
+	}
+	for _, n := range serials {
+		tmpl := &x509.Certificate{
+			SerialNumber: big.NewInt(n),
+			Subject:      pkix.Name{CommonName: fmt.Sprintf("%d.test.my.domain", n)},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+		}
+		der, err := x509.CreateCertificate(rand.Reader, tmpl, caCert, &key.PublicKey, key)
+		require.NoError(t, err)
+		s.certs[fmt.Sprintf("%02x", n)] = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	}
+
+	return s
+}
+
+func (s *testPKIServer) crl() string {
+	var entries []x509.RevocationListEntry
+	for serial := range s.inCRL {
+		n, ok := new(big.Int).SetString(serial, 16)
+		require.True(s.t, ok)
+		entries = append(entries, x509.RevocationListEntry{SerialNumber: n, RevocationTime: time.Now()})
+	}
+	der, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:                    big.NewInt(1),
+		ThisUpdate:                time.Now(),
+		NextUpdate:                time.Now().Add(time.Hour),
+		RevokedCertificateEntries: entries,
+	}, s.caCert, s.caKey)
+	require.NoError(s.t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der}))
+}
+
+func (s *testPKIServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var data map[string]interface{}
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/v1/pki/issuer/issuer-1/crl":
+		data = map[string]interface{}{"crl": s.crl()}
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/v1/pki/cert/"):
+		serial := strings.TrimPrefix(r.URL.Path, "/v1/pki/cert/")
+		cert, ok := s.certs[serial]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		data = map[string]interface{}{
+			"certificate":     string(cert),
+			"issuer_id":       "issuer-1",
+			"revocation_time": s.revoked[serial],
+		}
+	case r.Method == http.MethodPut && r.URL.Path == "/v1/pki/revoke":
+		var body map[string]string
+		require.NoError(s.t, json.NewDecoder(r.Body).Decode(&body))
+		serial := body[consts.FieldSerialNumber]
+		s.revoked[serial] = time.Now().Unix()
+		s.inCRL[serial] = true
+		data = map[string]interface{}{"revocation_time": s.revoked[serial]}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+}
+
+func TestRevokePKICertificates(t *testing.T) {
+	s := newTestPKIServer(t, 1, 2, 3)
+	s.revoked["02"] = 1700000000
+
+	config, ln := testutil.TestHTTPServer(t, s)
+	defer ln.Close()
+
+	client, err := api.NewClient(config)
+	require.NoError(t, err)
+
+	err = revokePKICertificates(context.Background(), client, "pki", []string{"03", "02", "04"}, nil)
+	assert.EqualError(t, err, `certificate "04" not found on PKI secret backend "pki"`)
+	assert.Empty(t, s.inCRL)
+
+	require.NoError(t, revokePKICertificates(context.Background(), client, "pki", []string{"03", "02"}, nil))
+	assert.Equal(t, map[string]bool{"03": true}, s.inCRL)
+	assert.Equal(t, int64(1700000000), s.revoked["02"])
+
+	// "04" was revoked and then removed by a tidy operation
+	require.NoError(t, revokePKICertificates(context.Background(), client, "pki", []string{"01", "03", "04"}, []string{"03", "04"}))
+	assert.Equal(t, map[string]bool{"01": true, "03": true}, s.inCRL)
+}
+
+func TestReadPKIRevocations(t *testing.T) {
+	s := newTestPKIServer(t, 1, 2, 3)
+	s.revoked["01"] = 1700000000
+	s.inCRL["01"] = true
+	// revoked, but the CRL was not rebuilt yet
+	s.revoked["02"] = 1700000001
+
+	config, ln := testutil.TestHTTPServer(t, s)
+	defer ln.Close()
+
+	client, err := api.NewClient(config)
+	require.NoError(t, err)
+
+	got, err := readPKIRevocations(context.Background(), client, "pki", []string{"04", "03", "02", "01"})
+	require.NoError(t, err)
+	assert.Equal(t, []pkiRevocation{
+		{SerialNumber: "01", RevocationTime: 1700000000, InCRL: true},
+		{SerialNumber: "02", RevocationTime: 1700000001, InCRL: false},
+		{SerialNumber: "04"},
+	}, got)
+}
@@ -0,0 +1,178 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+)
+
+const (
+	raftRemovePeerPath = "sys/storage/raft/remove-peer"
+
+	fieldDesiredNodeIDs = "desired_node_ids"
+	fieldNodeIDs        = "node_ids"
+	fieldRemovedNodeIDs = "removed_node_ids"
+)
+
+func raftPeerRemovalResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: raftPeerRemovalWrite,
+		UpdateContext: raftPeerRemovalWrite,
+		ReadContext:   provider.ReadContextWrapper(raftPeerRemovalRead),
+		DeleteContext: raftPeerRemovalDelete,
+		CustomizeDiff: raftPeerRemovalCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			fieldDesiredNodeIDs: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the nodes that should be peers of the Raft cluster. Any other peer is removed.",
+			},
+			fieldNodeIDs: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the current peers of the Raft cluster.",
+			},
+			fieldRemovedNodeIDs: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the peers removed by the last apply.",
+			},
+		},
+	}
+}
+
+func raftPeerRemovalWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	desired := expandStringSlice(d.Get(fieldDesiredNodeIDs).(*schema.Set).List())
+	removed, err := removeRaftPeers(ctx, client, desired)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(raftConfigurationPath)
+	if err := d.Set(fieldRemovedNodeIDs, removed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return raftPeerRemovalRead(ctx, d, meta)
+}
+
+func raftPeerRemovalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	peers, err := readRaftPeers(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	nodeIDs := make([]string, 0, len(peers))
+	for _, p := range peers {
+		nodeIDs = append(nodeIDs, p.NodeID)
+	}
+	if err := d.Set(fieldNodeIDs, nodeIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// raftPeerRemovalDelete only removes the resource from the state, the removed
+// peers are not added back to the cluster.
+func raftPeerRemovalDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Removing raft peer removal %q from state", d.Id())
+	return nil
+}
+
+// raftPeerRemovalCustomizeDiff plans an update when the cluster has peers
+// that are not in the desired set, e.g. after a node was replaced.
+func raftPeerRemovalCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	desired := d.Get(fieldDesiredNodeIDs).(*schema.Set)
+	for _, id := range d.Get(fieldNodeIDs).(*schema.Set).List() {
+		if !desired.Contains(id) {
+			if err := d.SetNewComputed(fieldNodeIDs); err != nil {
+				return err
+			}
+			return d.SetNewComputed(fieldRemovedNodeIDs)
+		}
+	}
+
+	return nil
+}
+
+// removeRaftPeers removes the peers of the Raft cluster that are not in
+// desired and returns their IDs. Nothing is removed if the leader is not in
+// desired, or if none of the desired nodes are peers of the cluster, since
+// this is almost certainly a mistake in the configuration.
+func removeRaftPeers(ctx context.Context, client *api.Client, desired []string) ([]string, error) {
+	peers, err := readRaftPeers(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	want := make(map[string]bool, len(desired))
+	for _, id := range desired {
+		want[id] = true
+	}
+
+	var remove []string
+	var leader string
+	for _, p := range peers {
+		if want[p.NodeID] {
+			continue
+		}
+		if p.Leader {
+			leader = p.NodeID
+		}
+		remove = append(remove, p.NodeID)
+	}
+
+	if len(remove) > 0 && len(remove) == len(peers) {
+		return nil, fmt.Errorf("refusing to remove all peers from the raft cluster, "+
+			"none of %v are peers of the cluster", desired)
+	}
+	if leader != "" {
+		return nil, fmt.Errorf("refusing to remove the leader %q from the raft cluster, "+
+			"step down the leader first or add it to %s", leader, fieldDesiredNodeIDs)
+	}
+
+	sort.Strings(remove)
+	removed := make([]string, 0, len(remove))
+	for _, id := range remove {
+		log.Printf("[DEBUG] Removing raft peer %q", id)
+		if _, err := client.Logical().WriteWithContext(ctx, raftRemovePeerPath, map[string]interface{}{
+			"server_id": id,
+		}); err != nil {
+			return removed, fmt.Errorf("error removing raft peer %q: %w", id, err)
+		}
+		log.Printf("[DEBUG] Removed raft peer %q", id)
+		removed = append(removed, id)
+	}
+
+	return removed, nil
+}
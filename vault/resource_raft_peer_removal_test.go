@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+// TestAccRaftPeerRemoval only keeps the peers of the cluster, so that nothing
+// is removed.
+func TestAccRaftPeerRemoval(t *testing.T) {
+	resourceName := "vault_raft_peer_removal.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck: func() {
+			testutil.SkipTestEnvSet(t, "SKIP_RAFT_TESTS")
+			testutil.TestAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "vault_raft_peers" "test" {}
+
+resource "vault_raft_peer_removal" "test" {
+  desired_node_ids = data.vault_raft_peers.test.peers[*].node_id
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, fieldNodeIDs+".#", "data.vault_raft_peers.test", fieldPeers+".#"),
+					resource.TestCheckResourceAttr(resourceName, fieldRemovedNodeIDs+".#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestRemoveRaftPeers(t *testing.T) {
+	servers := []map[string]interface{}{
+		{"node_id": "node1", "address": "10.0.0.1:8201", "leader": true, "voter": true},
+		{"node_id": "node2", "address": "10.0.0.2:8201", "leader": false, "voter": true},
+		{"node_id": "node3", "address": "10.0.0.3:8201", "leader": false, "voter": true},
+		{"node_id": "node4", "address": "10.0.0.4:8201", "leader": false, "voter": false},
+	}
+
+	tests := []struct {
+		name        string
+		desired     []string
+		wantRemoved []string
+		wantErr     string
+	}{
+		{
+			name:        "remove-stale",
+			desired:     []string{"node1", "node2", "node5"},
+			wantRemoved: []string{"node3", "node4"},
+		},
+		{
+			name:        "nothing-to-remove",
+			desired:     []string{"node1", "node2", "node3", "node4"},
+			wantRemoved: []string{},
+		},
+		{
+			name:    "leader",
+			desired: []string{"node2", "node3", "node4"},
+			wantErr: `refusing to remove the leader "node1" from the raft cluster, step down the leader first or add it to desired_node_ids`,
+		},
+		{
+			name:    "none-desired",
+			desired: []string{"node5"},
+			wantErr: "refusing to remove all peers from the raft cluster, none of [node5] are peers of the cluster",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var removed []string
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/v1/sys/storage/raft/configuration":
+					w.Header().Set("Content-Type", "application/json")
+					json.NewEncoder(w).Encode(map[string]interface{}{
+						"data": map[string]interface{}{
+							"config": map[string]interface{}{
+								"index":   42,
+								"servers": servers,
+							},
+						},
+					})
+				case r.Method == http.MethodPut && r.URL.Path == "/v1/sys/storage/raft/remove-peer":
+					var body map[string]string
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					mu.Lock()
+					removed = append(removed, body["server_id"])
+					mu.Unlock()
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			config, ln := testutil.TestHTTPServer(t, handler)
+			defer ln.Close()
+
+			client, err := api.NewClient(config)
+			require.NoError(t, err)
+
+			got, err := removeRaftPeers(context.Background(), client, tt.desired)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Empty(t, removed)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRemoved, got)
+			if len(tt.wantRemoved) > 0 {
+				assert.Equal(t, tt.wantRemoved, removed)
+			} else {
+				assert.Empty(t, removed)
+			}
+		})
+	}
+}
@@ -9,8 +9,12 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/vault/api"
 
@@ -23,20 +27,29 @@ import (
 var syncAssociationFieldsFromIDRegex = regexp.MustCompile("^(.+)/dest/(.+)/mount/(.+)/secret/(.+)$")
 
 const (
-	fieldSecretName = "secret_name"
-	fieldSyncStatus = "sync_status"
-	fieldUpdatedAt  = "updated_at"
-	fieldSubkey     = "sub_key"
+	fieldSecretName  = "secret_name"
+	fieldSyncStatus  = "sync_status"
+	fieldUpdatedAt   = "updated_at"
+	fieldSubkey      = "sub_key"
+	fieldLastError   = "last_error"
+	fieldWaitForSync = "wait_for_sync"
+
+	syncStatusSynced = "SYNCED"
 )
 
 func secretsSyncAssociationResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: provider.MountCreateContextWrapper(secretsSyncAssociationWrite, provider.VaultVersion116),
+		UpdateContext: secretsSyncAssociationUpdate,
 		ReadContext:   provider.ReadContextWrapper(secretsSyncAssociationRead),
 		DeleteContext: secretsSyncAssociationDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			consts.FieldName: {
@@ -63,6 +76,13 @@ func secretsSyncAssociationResource() *schema.Resource {
 				ForceNew:    true,
 				Description: "Specifies the name of the secret to synchronize.",
 			},
+			fieldWaitForSync: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Wait until every subkey of the secret is synced to the destination. " +
+					"Fails if the sync of a subkey reports an error.",
+			},
 			consts.FieldMetadata: {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -122,9 +142,114 @@ func secretsSyncAssociationWrite(ctx context.Context, d *schema.ResourceData, me
 	id := fmt.Sprintf("%s/dest/%s/mount/%s/secret/%s", destType, name, mount, secretName)
 	d.SetId(id)
 
+	if d.Get(fieldWaitForSync).(bool) {
+		if err := secretsSyncAssociationWait(ctx, d, meta, client, schema.TimeoutCreate); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return secretsSyncAssociationRead(ctx, d, meta)
 }
 
+func secretsSyncAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, e := provider.GetClient(d, meta)
+	if e != nil {
+		return diag.FromErr(e)
+	}
+
+	// wait_for_sync is the only argument that can be updated
+	if d.Get(fieldWaitForSync).(bool) {
+		if err := secretsSyncAssociationWait(ctx, d, meta, client, schema.TimeoutUpdate); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return secretsSyncAssociationRead(ctx, d, meta)
+}
+
+func secretsSyncAssociationWait(ctx context.Context, d *schema.ResourceData, meta interface{}, client *api.Client, timeoutKey string) error {
+	name := d.Get(consts.FieldName).(string)
+	destType := d.Get(consts.FieldType).(string)
+	secretName := d.Get(fieldSecretName).(string)
+
+	accessor, err := getMountAccessor(ctx, d, meta, d.Get(consts.FieldMount).(string))
+	if err != nil {
+		return fmt.Errorf("could not obtain accessor from given mount; err=%s", err)
+	}
+
+	return waitForSyncAssociation(ctx, client, name, destType, accessor, secretName, d.Timeout(timeoutKey))
+}
+
+// waitForSyncAssociation polls the associations of the destination until
+// every subkey of the secret is synced. It fails as soon as a subkey reports
+// an error status.
+func waitForSyncAssociation(ctx context.Context, client *api.Client, destName, destType, accessor, secretName string, timeout time.Duration) error {
+	path := fmt.Sprintf("%s/%s", syncutil.SecretsSyncDestinationPath(destName, destType), "associations")
+
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		log.Printf("[DEBUG] Checking sync status of %s/%s at %q", accessor, secretName, path)
+		resp, err := client.Logical().ReadWithContext(ctx, path)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("error reading associations from %q: %s", path, err))
+		}
+		if resp == nil {
+			return retry.RetryableError(fmt.Errorf("no associations found at %q", path))
+		}
+
+		model, err := getSyncAssociationModelFromResponse(resp)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		var pending []string
+		found := false
+		for _, v := range model.AssociatedSecrets {
+			if v.SecretName != secretName || v.Accessor != accessor {
+				continue
+			}
+			found = true
+
+			if isSyncErrorStatus(v.SyncStatus) {
+				return retry.NonRetryableError(syncAssociationError(v))
+			}
+			if v.SyncStatus != syncStatusSynced {
+				pending = append(pending, fmt.Sprintf("%s (%s)", syncAssociationSubkeyName(v), v.SyncStatus))
+			}
+		}
+
+		if !found {
+			return retry.RetryableError(fmt.Errorf("secret %s/%s is not associated with destination %q yet", accessor, secretName, destName))
+		}
+		if len(pending) > 0 {
+			sort.Strings(pending)
+			return retry.RetryableError(fmt.Errorf("waiting for subkeys to be synced: %s", strings.Join(pending, ", ")))
+		}
+
+		log.Printf("[DEBUG] Secret %s/%s is synced to destination %q", accessor, secretName, destName)
+		return nil
+	})
+}
+
+// isSyncErrorStatus reports whether a sync status is one of the error
+// statuses, e.g. EXTERNAL_SERVICE_ERROR or CLIENT_SIDE_ERROR.
+func isSyncErrorStatus(status string) bool {
+	return strings.HasSuffix(status, "_ERROR")
+}
+
+func syncAssociationSubkeyName(v syncAssociationData) string {
+	if v.Subkey == "" {
+		return v.SecretName
+	}
+	return fmt.Sprintf("%s/%s", v.SecretName, v.Subkey)
+}
+
+func syncAssociationError(v syncAssociationData) error {
+	if v.LastError != "" {
+		return fmt.Errorf("sync of %s failed with status %s: %s", syncAssociationSubkeyName(v), v.SyncStatus, v.LastError)
+	}
+	return fmt.Errorf("sync of %s failed with status %s", syncAssociationSubkeyName(v), v.SyncStatus)
+}
+
 func secretsSyncAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, e := provider.GetClient(d, meta)
 	if e != nil {
@@ -270,6 +395,7 @@ type syncAssociationData struct {
 	SyncStatus string `json:"sync_status"`
 	UpdatedAt  string `json:"updated_at"`
 	Subkey     string `json:"sub_key"`
+	LastError  string `json:"last_error"`
 }
 
 func getSyncAssociationModelFromResponse(resp *api.Secret) (*syncAssociationModel, error) {
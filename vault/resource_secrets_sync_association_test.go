@@ -4,11 +4,18 @@ package vault
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/hashicorp/terraform-provider-vault/internal/consts"
 	"github.com/hashicorp/terraform-provider-vault/internal/provider"
@@ -40,7 +47,7 @@ func TestSecretsSyncAssociation_gh(t *testing.T) {
 		}, PreventPostDestroyRefresh: true,
 		Steps: []resource.TestStep{
 			{
-				Config: testSecretsSyncAssociationConfig_gh(mount, accessToken, repoOwner, repoName, destName, secretName),
+				Config: testSecretsSyncAssociationConfig_gh(mount, accessToken, repoOwner, repoName, destName, secretName, false),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, consts.FieldMount, mount),
 					resource.TestCheckResourceAttr(resourceName, consts.FieldName, destName),
@@ -52,12 +59,19 @@ func TestSecretsSyncAssociation_gh(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.updated_at"),
 				),
 			},
-			testutil.GetImportTestStep(resourceName, false, nil),
+			testutil.GetImportTestStep(resourceName, false, nil, fieldWaitForSync),
+			{
+				Config: testSecretsSyncAssociationConfig_gh(mount, accessToken, repoOwner, repoName, destName, secretName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, fieldWaitForSync, "true"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.sync_status", syncStatusSynced),
+				),
+			},
 		},
 	})
 }
 
-func testSecretsSyncAssociationConfig_gh(mount, accessToken, owner, repoName, destName, secretName string) string {
+func testSecretsSyncAssociationConfig_gh(mount, accessToken, owner, repoName, destName, secretName string, waitForSync bool) string {
 	ret := fmt.Sprintf(`
 resource "vault_mount" "test" {
  path        = "%s"
@@ -89,7 +103,89 @@ resource "vault_secrets_sync_association" "test" {
   type        = vault_secrets_sync_gh_destination.test.type
   mount       = vault_mount.test.path
   secret_name = vault_kv_secret_v2.test.name
-}`, mount, secretName, destName, accessToken, owner, repoName)
+  wait_for_sync = %t
+}`, mount, secretName, destName, accessToken, owner, repoName, waitForSync)
 
 	return ret
 }
+
+func TestWaitForSyncAssociation(t *testing.T) {
+	association := func(status, lastError string) map[string]interface{} {
+		return map[string]interface{}{
+			"associated_secrets": map[string]interface{}{
+				"kv_1234/token": map[string]interface{}{
+					"accessor":    "kv_1234",
+					"secret_name": "token",
+					"sync_status": status,
+					"updated_at":  "2026-01-01T00:00:00Z",
+					"last_error":  lastError,
+				},
+				"kv_5678/token": map[string]interface{}{
+					"accessor":    "kv_5678",
+					"secret_name": "token",
+					"sync_status": "EXTERNAL_SERVICE_ERROR",
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		responses []map[string]interface{}
+		wantErr   string
+		wantReads int32
+	}{
+		{
+			name: "synced",
+			responses: []map[string]interface{}{
+				association("SYNCING", ""),
+				association("SYNCED", ""),
+			},
+			wantReads: 2,
+		},
+		{
+			name: "error",
+			responses: []map[string]interface{}{
+				association("SYNCING", ""),
+				association("CLIENT_SIDE_ERROR", "permission denied"),
+			},
+			wantErr:   "sync of token failed with status CLIENT_SIDE_ERROR: permission denied",
+			wantReads: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reads atomic.Int32
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/sys/sync/destinations/gh/dest/associations" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+
+				i := int(reads.Add(1)) - 1
+				if i >= len(tt.responses) {
+					i = len(tt.responses) - 1
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"data": tt.responses[i],
+				})
+			})
+
+			config, ln := testutil.TestHTTPServer(t, handler)
+			defer ln.Close()
+
+			client, err := api.NewClient(config)
+			require.NoError(t, err)
+
+			err = waitForSyncAssociation(context.Background(), client, "dest", "gh", "kv_1234", "token", time.Minute)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantReads, reads.Load())
+		})
+	}
+}
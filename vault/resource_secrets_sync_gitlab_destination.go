@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	syncutil "github.com/hashicorp/terraform-provider-vault/internal/sync"
+)
+
+const (
+	fieldProtected = "protected"
+	fieldMasked    = "masked"
+	gitlabSyncType = "gitlab"
+)
+
+var gitlabSyncWriteFields = []string{
+	fieldAccessToken,
+	consts.FieldAddress,
+	consts.FieldScope,
+	consts.FieldGroupID,
+	fieldProjectID,
+	fieldProtected,
+	fieldMasked,
+	consts.FieldGranularity,
+	consts.FieldSecretNameTemplate,
+	consts.FieldCustomTags,
+}
+
+var gitlabSyncReadFields = []string{
+	consts.FieldAddress,
+	consts.FieldScope,
+	consts.FieldGroupID,
+	fieldProjectID,
+	fieldProtected,
+	fieldMasked,
+	consts.FieldGranularity,
+	consts.FieldSecretNameTemplate,
+	consts.FieldCustomTags,
+}
+
+func gitlabSecretsSyncDestinationResource() *schema.Resource {
+	return provider.MustAddSecretsSyncCloudSchema(&schema.Resource{
+		CreateContext: provider.MountCreateContextWrapper(gitlabSecretsSyncDestinationCreateUpdate, provider.VaultVersion119),
+		UpdateContext: gitlabSecretsSyncDestinationCreateUpdate,
+		ReadContext:   provider.ReadContextWrapper(gitlabSecretsSyncDestinationRead),
+		DeleteContext: gitlabSecretsSyncDestinationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique name of the GitLab destination.",
+				ForceNew:    true,
+			},
+			fieldAccessToken: {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+				Description: "GitLab access token with the permissions to manage " +
+					"CI/CD variables.",
+			},
+			consts.FieldAddress: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Address of the GitLab instance. Defaults to 'https://gitlab.com/'.",
+			},
+			consts.FieldScope: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Level at which the CI/CD variables are managed. " +
+					"Can be 'project', 'group' or 'instance'.",
+				ValidateFunc: validation.StringInSlice([]string{"project", "group", "instance"}, false),
+			},
+			consts.FieldGroupID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the group where to manage CI/CD variables. Required if scope is 'group'.",
+			},
+			fieldProjectID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the project where to manage CI/CD variables. Required if scope is 'project'.",
+			},
+			fieldProtected: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If set to true, the variables are only exported to pipelines running on protected branches and tags.",
+			},
+			fieldMasked: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If set to true, the values of the variables are masked in job logs.",
+			},
+		},
+	})
+}
+
+func gitlabSecretsSyncDestinationCreateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	switch d.Get(consts.FieldScope).(string) {
+	case "group":
+		if _, ok := d.GetOk(consts.FieldGroupID); !ok {
+			return diag.Errorf("%q is required when %q is 'group'", consts.FieldGroupID, consts.FieldScope)
+		}
+	case "project":
+		if _, ok := d.GetOk(fieldProjectID); !ok {
+			return diag.Errorf("%q is required when %q is 'project'", fieldProjectID, consts.FieldScope)
+		}
+	}
+
+	return syncutil.SyncDestinationCreateUpdateWithOptions(ctx, d, meta, gitlabSyncType, gitlabSyncWriteFields, gitlabSyncReadFields, nil)
+}
+
+func gitlabSecretsSyncDestinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return syncutil.SyncDestinationRead(ctx, d, meta, gitlabSyncType, gitlabSyncReadFields, map[string]string{
+		consts.FieldGranularity: consts.FieldGranularityLevel,
+	})
+}
+
+func gitlabSecretsSyncDestinationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return syncutil.SyncDestinationDelete(ctx, d, meta, gitlabSyncType)
+}
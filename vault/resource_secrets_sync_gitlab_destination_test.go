@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestGitLabSecretsSyncDestination(t *testing.T) {
+	destName := acctest.RandomWithPrefix("tf-sync-dest-gitlab")
+
+	resourceName := "vault_secrets_sync_gitlab_destination.test"
+
+	values := testutil.SkipTestEnvUnset(t,
+		"GITLAB_ACCESS_TOKEN",
+		"GITLAB_PROJECT_ID",
+	)
+	accessToken := values[0]
+	projectID := values[1]
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck: func() {
+			acctestutil.TestAccPreCheck(t)
+			SkipIfAPIVersionLT(t, testProvider.Meta(), provider.VaultVersion119)
+		}, PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testGitLabSecretsSyncDestinationConfig(accessToken, projectID, destName, defaultSecretsSyncTemplate, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldName, destName),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldType, gitlabSyncType),
+					resource.TestCheckResourceAttr(resourceName, fieldAccessToken, accessToken),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldScope, "project"),
+					resource.TestCheckResourceAttr(resourceName, fieldProjectID, projectID),
+					resource.TestCheckResourceAttr(resourceName, fieldProtected, "false"),
+					resource.TestCheckResourceAttr(resourceName, fieldMasked, "true"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldSecretNameTemplate, defaultSecretsSyncTemplate),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldGranularity, "secret-path"),
+					resource.TestCheckResourceAttr(resourceName, "custom_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_tags.foo", "bar"),
+				),
+			},
+			{
+				Config: testGitLabSecretsSyncDestinationConfig(accessToken, projectID, destName, secretsKeyTemplate, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, fieldProtected, "true"),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldSecretNameTemplate, secretsKeyTemplate),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldGranularity, "secret-key"),
+					resource.TestCheckResourceAttr(resourceName, "custom_tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_tags.baz", "bux"),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil, fieldAccessToken),
+		},
+	})
+}
+
+func testGitLabSecretsSyncDestinationConfig(accessToken, projectID, destName, templ string, update bool) string {
+	return fmt.Sprintf(`
+resource "vault_secrets_sync_gitlab_destination" "test" {
+  name         = "%s"
+  access_token = "%s"
+  scope        = "project"
+  project_id   = "%s"
+  protected    = %t
+  masked       = true
+  %s
+}
+`, destName, accessToken, projectID, update, testSecretsSyncDestinationCommonConfig(templ, true, true, update))
+}
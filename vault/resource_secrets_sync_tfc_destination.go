@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	syncutil "github.com/hashicorp/terraform-provider-vault/internal/sync"
+)
+
+const (
+	fieldWorkspaceID   = "workspace_id"
+	fieldVariableSetID = "variable_set_id"
+	tfcSyncType        = "tfc"
+)
+
+var tfcSyncWriteFields = []string{
+	consts.FieldToken,
+	consts.FieldAddress,
+	consts.FieldOrganization,
+	fieldWorkspaceID,
+	fieldVariableSetID,
+	consts.FieldGranularity,
+	consts.FieldSecretNameTemplate,
+	consts.FieldCustomTags,
+}
+
+var tfcSyncReadFields = []string{
+	consts.FieldAddress,
+	consts.FieldOrganization,
+	fieldWorkspaceID,
+	fieldVariableSetID,
+	consts.FieldGranularity,
+	consts.FieldSecretNameTemplate,
+	consts.FieldCustomTags,
+}
+
+func tfcSecretsSyncDestinationResource() *schema.Resource {
+	return provider.MustAddSecretsSyncCloudSchema(&schema.Resource{
+		CreateContext: provider.MountCreateContextWrapper(tfcSecretsSyncDestinationCreateUpdate, provider.VaultVersion119),
+		UpdateContext: tfcSecretsSyncDestinationCreateUpdate,
+		ReadContext:   provider.ReadContextWrapper(tfcSecretsSyncDestinationRead),
+		DeleteContext: tfcSecretsSyncDestinationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			consts.FieldName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique name of the HCP Terraform destination.",
+				ForceNew:    true,
+			},
+			consts.FieldToken: {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+				Description: "HCP Terraform team or user token with the permissions to manage " +
+					"the variables of the workspace or variable set.",
+			},
+			consts.FieldAddress: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				Description: "Address of HCP Terraform or Terraform Enterprise. " +
+					"Defaults to 'https://app.terraform.io'.",
+			},
+			consts.FieldOrganization: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the organization that owns the workspace or variable set.",
+			},
+			fieldWorkspaceID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "ID of the workspace where to manage variables.",
+				ExactlyOneOf: []string{fieldWorkspaceID, fieldVariableSetID},
+			},
+			fieldVariableSetID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "ID of the variable set where to manage variables.",
+				ExactlyOneOf: []string{fieldWorkspaceID, fieldVariableSetID},
+			},
+		},
+	})
+}
+
+func tfcSecretsSyncDestinationCreateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return syncutil.SyncDestinationCreateUpdateWithOptions(ctx, d, meta, tfcSyncType, tfcSyncWriteFields, tfcSyncReadFields, nil)
+}
+
+func tfcSecretsSyncDestinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return syncutil.SyncDestinationRead(ctx, d, meta, tfcSyncType, tfcSyncReadFields, map[string]string{
+		consts.FieldGranularity: consts.FieldGranularityLevel,
+	})
+}
+
+func tfcSecretsSyncDestinationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return syncutil.SyncDestinationDelete(ctx, d, meta, tfcSyncType)
+}
@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2016, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-vault/acctestutil"
+	"github.com/hashicorp/terraform-provider-vault/internal/consts"
+	"github.com/hashicorp/terraform-provider-vault/internal/provider"
+	"github.com/hashicorp/terraform-provider-vault/testutil"
+)
+
+func TestTFCSecretsSyncDestination(t *testing.T) {
+	destName := acctest.RandomWithPrefix("tf-sync-dest-tfc")
+
+	resourceName := "vault_secrets_sync_tfc_destination.test"
+
+	values := testutil.SkipTestEnvUnset(t,
+		"TFC_TOKEN",
+		"TFC_ORGANIZATION",
+		"TFC_VARIABLE_SET_ID",
+	)
+	token := values[0]
+	organization := values[1]
+	variableSetID := values[2]
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck: func() {
+			acctestutil.TestAccPreCheck(t)
+			SkipIfAPIVersionLT(t, testProvider.Meta(), provider.VaultVersion119)
+		}, PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testTFCSecretsSyncDestinationConfig(token, organization, variableSetID, destName, defaultSecretsSyncTemplate, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldName, destName),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldType, tfcSyncType),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldToken, token),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldOrganization, organization),
+					resource.TestCheckResourceAttr(resourceName, fieldVariableSetID, variableSetID),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldSecretNameTemplate, defaultSecretsSyncTemplate),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldGranularity, "secret-path"),
+					resource.TestCheckResourceAttr(resourceName, "custom_tags.%", "1"),
+				),
+			},
+			{
+				Config: testTFCSecretsSyncDestinationConfig(token, organization, variableSetID, destName, secretsKeyTemplate, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, consts.FieldSecretNameTemplate, secretsKeyTemplate),
+					resource.TestCheckResourceAttr(resourceName, consts.FieldGranularity, "secret-key"),
+					resource.TestCheckResourceAttr(resourceName, "custom_tags.%", "2"),
+				),
+			},
+			testutil.GetImportTestStep(resourceName, false, nil, consts.FieldToken),
+		},
+	})
+}
+
+func TestTFCSecretsSyncDestination_Target(t *testing.T) {
+	destName := acctest.RandomWithPrefix("tf-sync-dest-tfc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(context.Background(), t),
+		PreCheck: func() {
+			acctestutil.TestAccPreCheck(t)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_secrets_sync_tfc_destination" "test" {
+  name            = "%s"
+  token           = "token"
+  organization    = "org"
+  workspace_id    = "ws-123"
+  variable_set_id = "varset-123"
+}
+`, destName),
+				ExpectError: regexp.MustCompile(`only one of .workspace_id,variable_set_id. can be specified`),
+			},
+		},
+	})
+}
+
+func testTFCSecretsSyncDestinationConfig(token, organization, variableSetID, destName, templ string, update bool) string {
+	return fmt.Sprintf(`
+resource "vault_secrets_sync_tfc_destination" "test" {
+  name            = "%s"
+  token           = "%s"
+  organization    = "%s"
+  variable_set_id = "%s"
+  %s
+}
+`, destName, token, organization, variableSetID, testSecretsSyncDestinationCommonConfig(templ, true, true, update))
+}